@@ -0,0 +1,121 @@
+package core
+
+import (
+	math32 "github.com/chewxy/math32"
+)
+
+// VoxelSize returns the world-space dimensions of a single voxel cell, derived from the grid's bounds
+// and voxel counts.
+func (vg *VoxelGrid) VoxelSize() [3]float32 {
+	return [3]float32{
+		(vg.max[0] - vg.min[0]) / float32(vg.counts[0]),
+		(vg.max[1] - vg.min[1]) / float32(vg.counts[1]),
+		(vg.max[2] - vg.min[2]) / float32(vg.counts[2]),
+	}
+}
+
+// VoxelVolume returns the world-space volume of a single voxel cell.
+func (vg *VoxelGrid) VoxelVolume() float32 {
+	size := vg.VoxelSize()
+	return size[0] * size[1] * size[2]
+}
+
+// Bounds returns the world-space axis-aligned bounding box the grid occupies.
+func (vg *VoxelGrid) Bounds() (min, max [3]float32) {
+	return vg.min, vg.max
+}
+
+// Dimensions returns the grid's size in voxels along each axis.
+func (vg *VoxelGrid) Dimensions() [3]uint {
+	return vg.counts
+}
+
+// Resolution returns the grid's voxel density in voxels per world unit.
+func (vg *VoxelGrid) Resolution() uint {
+	return vg.resolution
+}
+
+// WorldToVoxel converts a world-space position to the voxel cell that contains it. inside reports
+// whether p actually falls within the grid's bounds; if it doesn't, the returned coordinates are zero.
+func (vg *VoxelGrid) WorldToVoxel(p [3]float32) (x, y, z uint, inside bool) {
+	size := vg.VoxelSize()
+
+	rel := [3]float32{
+		(p[0] - vg.min[0]) / size[0],
+		(p[1] - vg.min[1]) / size[1],
+		(p[2] - vg.min[2]) / size[2],
+	}
+
+	for i := 0; i < 3; i++ {
+		if rel[i] < 0 || rel[i] >= float32(vg.counts[i]) {
+			return 0, 0, 0, false
+		}
+	}
+
+	return uint(rel[0]), uint(rel[1]), uint(rel[2]), true
+}
+
+// VoxelToWorld returns the world-space position of the center of the voxel cell at the given coordinates.
+func (vg *VoxelGrid) VoxelToWorld(x, y, z uint) [3]float32 {
+	size := vg.VoxelSize()
+	return [3]float32{
+		vg.min[0] + (float32(x)+0.5)*size[0],
+		vg.min[1] + (float32(y)+0.5)*size[1],
+		vg.min[2] + (float32(z)+0.5)*size[2],
+	}
+}
+
+// SampleAt trilinearly interpolates the voxel value at an arbitrary world-space position between the
+// eight surrounding cell centers, clamping to the grid's edge cells for positions outside the bounds.
+func (vg *VoxelGrid) SampleAt(p [3]float32) Voxel {
+	size := vg.VoxelSize()
+
+	// cell is the continuous cell-center coordinate of p, e.g. a value of 2.3 on an axis means p sits
+	// 30% of the way from the center of cell 2 to the center of cell 3.
+	cell := [3]float32{
+		(p[0]-vg.min[0])/size[0] - 0.5,
+		(p[1]-vg.min[1])/size[1] - 0.5,
+		(p[2]-vg.min[2])/size[2] - 0.5,
+	}
+
+	var lo, hi [3]uint
+	var t [3]float32
+	for i := 0; i < 3; i++ {
+		f := math32.Floor(cell[i])
+		t[i] = cell[i] - f
+
+		loIdx := clampCoordinate(int(f), vg.counts[i])
+		hiIdx := clampCoordinate(int(f)+1, vg.counts[i])
+		lo[i], hi[i] = loIdx, hiIdx
+	}
+
+	c000 := vg.Get(lo[0], lo[1], lo[2]).Density
+	c100 := vg.Get(hi[0], lo[1], lo[2]).Density
+	c010 := vg.Get(lo[0], hi[1], lo[2]).Density
+	c110 := vg.Get(hi[0], hi[1], lo[2]).Density
+	c001 := vg.Get(lo[0], lo[1], hi[2]).Density
+	c101 := vg.Get(hi[0], lo[1], hi[2]).Density
+	c011 := vg.Get(lo[0], hi[1], hi[2]).Density
+	c111 := vg.Get(hi[0], hi[1], hi[2]).Density
+
+	c00 := c000 + t[0]*(c100-c000)
+	c10 := c010 + t[0]*(c110-c010)
+	c01 := c001 + t[0]*(c101-c001)
+	c11 := c011 + t[0]*(c111-c011)
+
+	c0 := c00 + t[1]*(c10-c00)
+	c1 := c01 + t[1]*(c11-c01)
+
+	return Voxel{Density: c0 + t[2]*(c1-c0)}
+}
+
+// clampCoordinate clamps an integer voxel index on one axis into the valid [0, count) range.
+func clampCoordinate(v int, count uint) uint {
+	if v < 0 {
+		return 0
+	}
+	if v >= int(count) {
+		return count - 1
+	}
+	return uint(v)
+}
@@ -0,0 +1,64 @@
+package core
+
+import "testing"
+
+// TestNewBoundedVoxelGridBounds checks that a bounded grid reports the bounds, size, and volume implied
+// by the requested box and resolution.
+func TestNewBoundedVoxelGridBounds(t *testing.T) {
+	vg := NewBoundedVoxelGrid([3]float32{1, 2, 3}, [3]float32{3, 4, 5}, 4)
+
+	min, max := vg.Bounds()
+	if min != [3]float32{1, 2, 3} {
+		t.Errorf("expected min of {1, 2, 3}, got %v", min)
+	}
+	if max != [3]float32{3, 4, 5} {
+		t.Errorf("expected max of {3, 4, 5}, got %v", max)
+	}
+
+	size := vg.VoxelSize()
+	want := [3]float32{0.25, 0.25, 0.25}
+	if size != want {
+		t.Errorf("expected voxel size of %v, got %v", want, size)
+	}
+
+	if volume := vg.VoxelVolume(); volume != 0.25*0.25*0.25 {
+		t.Errorf("expected voxel volume of %v, got %v", 0.25*0.25*0.25, volume)
+	}
+}
+
+// TestWorldToVoxelRoundTrip checks that converting a voxel's center to world space and back recovers the
+// original coordinates, and that points outside the bounds are reported as such.
+func TestWorldToVoxelRoundTrip(t *testing.T) {
+	vg := NewBoundedVoxelGrid([3]float32{0, 0, 0}, [3]float32{2, 2, 2}, 4)
+
+	for x := uint(0); x < 8; x++ {
+		p := vg.VoxelToWorld(x, 0, 0)
+		gotX, _, _, inside := vg.WorldToVoxel(p)
+		if !inside {
+			t.Fatalf("expected %v to be inside the grid", p)
+		}
+		if gotX != x {
+			t.Errorf("expected round trip to recover x=%d, got %d", x, gotX)
+		}
+	}
+
+	if _, _, _, inside := vg.WorldToVoxel([3]float32{-1, 0, 0}); inside {
+		t.Error("expected a point outside the bounds to report inside=false")
+	}
+}
+
+// TestSampleAtInterpolates checks that SampleAt produces values between two neighboring voxels when
+// queried at the midpoint of their centers.
+func TestSampleAtInterpolates(t *testing.T) {
+	vg := NewBoundedVoxelGrid([3]float32{0, 0, 0}, [3]float32{2, 1, 1}, 4)
+	vg.Fill(0)
+	vg.Set(0, 0, 0, Voxel{Density: 1})
+
+	center0 := vg.VoxelToWorld(0, 0, 0)
+	center1 := vg.VoxelToWorld(1, 0, 0)
+	midpoint := [3]float32{(center0[0] + center1[0]) / 2, center0[1], center0[2]}
+
+	if got := vg.SampleAt(midpoint); got.Density != 0.5 {
+		t.Errorf("expected the midpoint between a 1 and a 0 voxel to sample to 0.5, got %v", got.Density)
+	}
+}
@@ -0,0 +1,19 @@
+package core
+
+// FieldFunc samples a scalar field at a world-space position. It is the extension point procedural
+// generators (see the generate subpackage) plug into via Apply.
+type FieldFunc func(x, y, z float32) float32
+
+// Apply fills every voxel in the grid by sampling fn at the voxel's world-space center.
+func (vg *VoxelGrid) Apply(fn FieldFunc) *VoxelGrid {
+	for z := uint(0); z < vg.counts[2]; z++ {
+		for y := uint(0); y < vg.counts[1]; y++ {
+			for x := uint(0); x < vg.counts[0]; x++ {
+				p := vg.VoxelToWorld(x, y, z)
+				vg.Set(x, y, z, Voxel{Density: fn(p[0], p[1], p[2])})
+			}
+		}
+	}
+
+	return vg
+}
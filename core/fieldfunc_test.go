@@ -0,0 +1,25 @@
+package core
+
+import "testing"
+
+// TestApplySamplesWorldSpaceCenters checks that Apply fills each voxel by sampling fn at that voxel's
+// world-space center, rather than at its grid index.
+func TestApplySamplesWorldSpaceCenters(t *testing.T) {
+	vg := NewBoundedVoxelGrid([3]float32{0, 0, 0}, [3]float32{4, 4, 4}, 4)
+
+	vg.Apply(func(x, y, z float32) float32 {
+		return x + y + z
+	})
+
+	for z := uint(0); z < 4; z++ {
+		for y := uint(0); y < 4; y++ {
+			for x := uint(0); x < 4; x++ {
+				p := vg.VoxelToWorld(x, y, z)
+				want := p[0] + p[1] + p[2]
+				if got := vg.Get(x, y, z); got.Density != want {
+					t.Fatalf("voxel (%d,%d,%d): got %v, want %v", x, y, z, got, want)
+				}
+			}
+		}
+	}
+}
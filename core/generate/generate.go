@@ -0,0 +1,126 @@
+// Package generate provides procedural content generators for core.VoxelGrid. Generators are expressed
+// as core.FieldFunc values -- plain functions of a world-space position to a scalar -- so they can be
+// sampled directly via VoxelGrid.Apply or composed with the SDF helpers below before being applied.
+package generate
+
+import (
+	math32 "github.com/chewxy/math32"
+
+	"github.com/Glenn-Gray-Labs/OpenPAF/core"
+)
+
+// FieldFunc is an alias of core.FieldFunc, kept local so generator signatures in this package don't
+// force every caller to import core directly.
+type FieldFunc = core.FieldFunc
+
+// Sphere returns a signed-distance field for a sphere: negative inside, positive outside, zero on the
+// surface.
+func Sphere(center [3]float32, radius float32) FieldFunc {
+	return func(x, y, z float32) float32 {
+		dx, dy, dz := x-center[0], y-center[1], z-center[2]
+		return math32.Sqrt(dx*dx+dy*dy+dz*dz) - radius
+	}
+}
+
+// Box returns a signed-distance field for an axis-aligned box spanning min to max.
+func Box(min, max [3]float32) FieldFunc {
+	center := [3]float32{(min[0] + max[0]) / 2, (min[1] + max[1]) / 2, (min[2] + max[2]) / 2}
+	half := [3]float32{(max[0] - min[0]) / 2, (max[1] - min[1]) / 2, (max[2] - min[2]) / 2}
+
+	return func(x, y, z float32) float32 {
+		qx := math32.Abs(x-center[0]) - half[0]
+		qy := math32.Abs(y-center[1]) - half[1]
+		qz := math32.Abs(z-center[2]) - half[2]
+
+		outside := math32.Sqrt(math32.Max(qx, 0)*math32.Max(qx, 0) +
+			math32.Max(qy, 0)*math32.Max(qy, 0) +
+			math32.Max(qz, 0)*math32.Max(qz, 0))
+		inside := math32.Min(math32.Max(qx, math32.Max(qy, qz)), 0)
+
+		return outside + inside
+	}
+}
+
+// Heightmap returns a field that is 1 below surface(x, z) and 0 above it, mirroring the common
+// heightmap-to-voxel generation approach used by voxel terrain games.
+func Heightmap(surface func(x, z float32) float32) FieldFunc {
+	return func(x, y, z float32) float32 {
+		if y < surface(x, z) {
+			return 1
+		}
+		return 0
+	}
+}
+
+// Union combines two signed-distance fields into their union (the nearer surface wins).
+func Union(a, b FieldFunc) FieldFunc {
+	return func(x, y, z float32) float32 {
+		return math32.Min(a(x, y, z), b(x, y, z))
+	}
+}
+
+// Intersect combines two signed-distance fields into their intersection.
+func Intersect(a, b FieldFunc) FieldFunc {
+	return func(x, y, z float32) float32 {
+		return math32.Max(a(x, y, z), b(x, y, z))
+	}
+}
+
+// Subtract removes b's volume from a.
+func Subtract(a, b FieldFunc) FieldFunc {
+	return func(x, y, z float32) float32 {
+		return math32.Max(a(x, y, z), -b(x, y, z))
+	}
+}
+
+// SmoothMin returns a combinator that unions two signed-distance fields with a smooth, rounded blend
+// instead of a hard minimum; k controls the blend radius; k == 0 degenerates to Union.
+func SmoothMin(k float32) func(a, b FieldFunc) FieldFunc {
+	return func(a, b FieldFunc) FieldFunc {
+		return func(x, y, z float32) float32 {
+			av, bv := a(x, y, z), b(x, y, z)
+			if k <= 0 {
+				return math32.Min(av, bv)
+			}
+
+			h := math32.Max(k-math32.Abs(av-bv), 0) / k
+			return math32.Min(av, bv) - h*h*k*0.25
+		}
+	}
+}
+
+// FBm layers base at increasing frequency and decreasing amplitude across octaves many octaves,
+// producing fractal Brownian motion. lacunarity controls the per-octave frequency multiplier (values
+// around 2 are typical) and gain controls the per-octave amplitude falloff (values around 0.5 are
+// typical).
+func FBm(base FieldFunc, octaves int, lacunarity, gain float32) FieldFunc {
+	return func(x, y, z float32) float32 {
+		var sum, amplitude, frequency float32 = 0, 1, 1
+
+		for i := 0; i < octaves; i++ {
+			sum += amplitude * base(x*frequency, y*frequency, z*frequency)
+			frequency *= lacunarity
+			amplitude *= gain
+		}
+
+		return sum
+	}
+}
+
+// RidgedMulti layers base the same way FBm does, but folds each octave's contribution through
+// 1-|n| before accumulating so valleys between octaves sharpen into ridges -- the classic technique
+// for generating mountain-range-like terrain from noise.
+func RidgedMulti(base FieldFunc, octaves int, lacunarity, gain float32) FieldFunc {
+	return func(x, y, z float32) float32 {
+		var sum, amplitude, frequency float32 = 0, 1, 1
+
+		for i := 0; i < octaves; i++ {
+			n := 1 - math32.Abs(base(x*frequency, y*frequency, z*frequency))
+			sum += amplitude * n * n
+			frequency *= lacunarity
+			amplitude *= gain
+		}
+
+		return sum
+	}
+}
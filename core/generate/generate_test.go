@@ -0,0 +1,131 @@
+package generate
+
+import (
+	"testing"
+
+	"github.com/Glenn-Gray-Labs/OpenPAF/core"
+)
+
+// TestApplySphereFillsExpectedVoxels checks that applying a Sphere SDF through VoxelGrid.Apply marks
+// voxels inside the radius as occupied (positive density) and voxels outside as empty, matching the
+// sign convention an SDF-style generator is expected to follow.
+func TestApplySphereFillsExpectedVoxels(t *testing.T) {
+	vg := core.NewBoundedVoxelGrid([3]float32{-1, -1, -1}, [3]float32{1, 1, 1}, 4)
+
+	sdf := Sphere([3]float32{0, 0, 0}, 0.5)
+	vg.Apply(func(x, y, z float32) float32 {
+		return -sdf(x, y, z)
+	})
+
+	inside := vg.SampleAt([3]float32{0, 0, 0})
+	if inside.Density <= 0 {
+		t.Errorf("expected the grid center to be inside the sphere, got density %v", inside.Density)
+	}
+
+	outside := vg.SampleAt([3]float32{0.9, 0.9, 0.9})
+	if outside.Density >= 0 {
+		t.Errorf("expected the grid corner to be outside the sphere, got density %v", outside.Density)
+	}
+}
+
+// TestBoxSDFSigns checks that the Box SDF is negative inside the box, positive outside, and
+// approximately zero on its surface.
+func TestBoxSDFSigns(t *testing.T) {
+	box := Box([3]float32{-1, -1, -1}, [3]float32{1, 1, 1})
+
+	if d := box(0, 0, 0); d >= 0 {
+		t.Errorf("expected the box center to be inside (negative), got %v", d)
+	}
+	if d := box(2, 2, 2); d <= 0 {
+		t.Errorf("expected a point outside the box to be positive, got %v", d)
+	}
+	if d := box(1, 0, 0); d > 0.01 || d < -0.01 {
+		t.Errorf("expected a point on the box face to be approximately zero, got %v", d)
+	}
+}
+
+// TestUnionIntersectSubtract checks the boolean SDF combinators against two overlapping spheres at a
+// point that lies inside both, inside only one, and inside neither.
+func TestUnionIntersectSubtract(t *testing.T) {
+	a := Sphere([3]float32{0, 0, 0}, 1)
+	b := Sphere([3]float32{0.5, 0, 0}, 1)
+
+	const bothPoint, aOnlyPoint, neitherPoint = 0.25, -0.9, 10
+
+	if d := Union(a, b)(bothPoint, 0, 0); d >= 0 {
+		t.Errorf("expected union to be inside at a point inside both spheres, got %v", d)
+	}
+	if d := Intersect(a, b)(aOnlyPoint, 0, 0); d <= 0 {
+		t.Errorf("expected intersect to be outside at a point inside only one sphere, got %v", d)
+	}
+	if d := Subtract(a, b)(aOnlyPoint, 0, 0); d >= 0 {
+		t.Errorf("expected subtract(a, b) to keep a point inside a but outside b, got %v", d)
+	}
+	if d := Union(a, b)(neitherPoint, 0, 0); d <= 0 {
+		t.Errorf("expected union to be outside a point far from both spheres, got %v", d)
+	}
+}
+
+// TestHeightmapFillsBelowSurface checks that Heightmap returns 1 below the surface function and 0
+// above it.
+func TestHeightmapFillsBelowSurface(t *testing.T) {
+	field := Heightmap(func(x, z float32) float32 { return 0 })
+
+	if v := field(0, -1, 0); v != 1 {
+		t.Errorf("expected 1 below the surface, got %v", v)
+	}
+	if v := field(0, 1, 0); v != 0 {
+		t.Errorf("expected 0 above the surface, got %v", v)
+	}
+}
+
+// TestNoiseIsDeterministicAndBounded checks that Perlin3D and OpenSimplex3D are pure functions of
+// (seed, position) and stay within the documented roughly [-1, 1] range.
+func TestNoiseIsDeterministicAndBounded(t *testing.T) {
+	for name, field := range map[string]FieldFunc{
+		"Perlin3D":      Perlin3D(42),
+		"OpenSimplex3D": OpenSimplex3D(42),
+	} {
+		for x := float32(0); x < 4; x += 0.37 {
+			a := field(x, x*1.3, x*0.7)
+			b := field(x, x*1.3, x*0.7)
+			if a != b {
+				t.Fatalf("%s: expected deterministic output, got %v then %v", name, a, b)
+			}
+			if a < -1.5 || a > 1.5 {
+				t.Errorf("%s: expected output roughly within [-1, 1], got %v", name, a)
+			}
+		}
+	}
+}
+
+// TestFBmAccumulatesOctaves checks that FBm with a single octave matches its base field exactly, and
+// that adding octaves changes the result.
+func TestFBmAccumulatesOctaves(t *testing.T) {
+	base := Perlin3D(7)
+
+	single := FBm(base, 1, 2, 0.5)
+	if got, want := single(0.3, 0.6, 0.9), base(0.3, 0.6, 0.9); got != want {
+		t.Errorf("expected a single-octave FBm to match its base field, got %v want %v", got, want)
+	}
+
+	multi := FBm(base, 4, 2, 0.5)
+	if multi(0.3, 0.6, 0.9) == single(0.3, 0.6, 0.9) {
+		t.Error("expected additional octaves to change the sampled value")
+	}
+}
+
+// TestSmoothMinDegeneratesToUnion checks that SmoothMin(0) matches Union exactly, as documented.
+func TestSmoothMinDegeneratesToUnion(t *testing.T) {
+	a := Sphere([3]float32{0, 0, 0}, 1)
+	b := Sphere([3]float32{0.5, 0, 0}, 1)
+
+	blend := SmoothMin(0)(a, b)
+	union := Union(a, b)
+
+	for _, p := range [][3]float32{{0, 0, 0}, {0.5, 0, 0}, {2, 2, 2}} {
+		if got, want := blend(p[0], p[1], p[2]), union(p[0], p[1], p[2]); got != want {
+			t.Errorf("expected SmoothMin(0) to match Union at %v, got %v want %v", p, got, want)
+		}
+	}
+}
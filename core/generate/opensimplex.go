@@ -0,0 +1,86 @@
+package generate
+
+import (
+	math32 "github.com/chewxy/math32"
+)
+
+// simplex3DSkew and simplex3DUnskew are the standard skewing factors for mapping between the regular
+// coordinate grid and the triangular (simplex) grid in 3 dimensions.
+const (
+	simplex3DSkew   = 1.0 / 3.0
+	simplex3DUnskew = 1.0 / 6.0
+)
+
+// OpenSimplex3D returns a deterministic, seeded 3D simplex-style gradient noise field in roughly the
+// [-1, 1] range. Simplex noise evaluates a triangular lattice rather than Perlin3D's cubic one,
+// which keeps the per-sample cost lower at higher dimensions and avoids the axis-aligned artifacts
+// classic Perlin noise can show.
+func OpenSimplex3D(seed int64) FieldFunc {
+	perm := newPermutationTable(seed)
+
+	hash := func(i, j, k int) int {
+		return perm[(perm[(perm[i&255]+j)&255]+k)&255] % 12
+	}
+
+	return func(x, y, z float32) float32 {
+		s := (x + y + z) * simplex3DSkew
+		i := math32.Floor(x + s)
+		j := math32.Floor(y + s)
+		k := math32.Floor(z + s)
+
+		t := (i + j + k) * simplex3DUnskew
+		x0o, y0o, z0o := i-t, j-t, k-t
+		x0, y0, z0 := x-x0o, y-y0o, z-z0o
+
+		var i1, j1, k1 int // offsets for the second corner
+		var i2, j2, k2 int // offsets for the third corner
+
+		switch {
+		case x0 >= y0 && y0 >= z0:
+			i1, j1, k1, i2, j2, k2 = 1, 0, 0, 1, 1, 0
+		case x0 >= z0 && z0 >= y0:
+			i1, j1, k1, i2, j2, k2 = 1, 0, 0, 1, 0, 1
+		case y0 >= z0 && z0 >= x0:
+			i1, j1, k1, i2, j2, k2 = 0, 1, 0, 0, 1, 1
+		case z0 >= x0 && x0 >= y0:
+			i1, j1, k1, i2, j2, k2 = 0, 0, 1, 1, 0, 1
+		case y0 >= x0 && x0 >= z0:
+			i1, j1, k1, i2, j2, k2 = 0, 1, 0, 1, 1, 0
+		default:
+			i1, j1, k1, i2, j2, k2 = 0, 0, 1, 0, 1, 1
+		}
+
+		x1 := x0 - float32(i1) + simplex3DUnskew
+		y1 := y0 - float32(j1) + simplex3DUnskew
+		z1 := z0 - float32(k1) + simplex3DUnskew
+		x2 := x0 - float32(i2) + 2*simplex3DUnskew
+		y2 := y0 - float32(j2) + 2*simplex3DUnskew
+		z2 := z0 - float32(k2) + 2*simplex3DUnskew
+		x3 := x0 - 1 + 3*simplex3DUnskew
+		y3 := y0 - 1 + 3*simplex3DUnskew
+		z3 := z0 - 1 + 3*simplex3DUnskew
+
+		ii, jj, kk := int(i), int(j), int(k)
+
+		n0 := simplexCornerContribution(x0, y0, z0, perlinGradients[hash(ii, jj, kk)])
+		n1 := simplexCornerContribution(x1, y1, z1, perlinGradients[hash(ii+i1, jj+j1, kk+k1)])
+		n2 := simplexCornerContribution(x2, y2, z2, perlinGradients[hash(ii+i2, jj+j2, kk+k2)])
+		n3 := simplexCornerContribution(x3, y3, z3, perlinGradients[hash(ii+1, jj+1, kk+1)])
+
+		// 32 normalizes the summed corner contributions to roughly [-1, 1].
+		return 32 * (n0 + n1 + n2 + n3)
+	}
+}
+
+// simplexCornerContribution returns one corner's contribution to the simplex noise sum: zero once the
+// corner falls outside the contribution radius, otherwise a smoothed falloff times the gradient dot
+// product.
+func simplexCornerContribution(x, y, z float32, g [3]float32) float32 {
+	t := 0.6 - x*x - y*y - z*z
+	if t < 0 {
+		return 0
+	}
+
+	t *= t
+	return t * t * (g[0]*x + g[1]*y + g[2]*z)
+}
@@ -0,0 +1,92 @@
+package generate
+
+import (
+	math32 "github.com/chewxy/math32"
+	"math/rand"
+)
+
+// perlinGradients are the 12 edge-midpoint gradient directions used by Ken Perlin's improved noise.
+var perlinGradients = [12][3]float32{
+	{1, 1, 0}, {-1, 1, 0}, {1, -1, 0}, {-1, -1, 0},
+	{1, 0, 1}, {-1, 0, 1}, {1, 0, -1}, {-1, 0, -1},
+	{0, 1, 1}, {0, -1, 1}, {0, 1, -1}, {0, -1, -1},
+}
+
+// Perlin3D returns a deterministic, seeded 3D Perlin noise field in roughly the [-1, 1] range, using Ken
+// Perlin's 2002 "improved noise" formulation (quintic fade, gradient dot products at lattice corners).
+func Perlin3D(seed int64) FieldFunc {
+	perm := newPermutationTable(seed)
+
+	return func(x, y, z float32) float32 {
+		xi := int(math32.Floor(x)) & 255
+		yi := int(math32.Floor(y)) & 255
+		zi := int(math32.Floor(z)) & 255
+
+		xf := x - math32.Floor(x)
+		yf := y - math32.Floor(y)
+		zf := z - math32.Floor(z)
+
+		u := fade(xf)
+		v := fade(yf)
+		w := fade(zf)
+
+		hash := func(i, j, k int) int {
+			return perm[(perm[(perm[i&255]+j)&255]+k)&255] % 12
+		}
+
+		dot := func(g [3]float32, dx, dy, dz float32) float32 {
+			return g[0]*dx + g[1]*dy + g[2]*dz
+		}
+
+		n000 := dot(perlinGradients[hash(xi, yi, zi)], xf, yf, zf)
+		n100 := dot(perlinGradients[hash(xi+1, yi, zi)], xf-1, yf, zf)
+		n010 := dot(perlinGradients[hash(xi, yi+1, zi)], xf, yf-1, zf)
+		n110 := dot(perlinGradients[hash(xi+1, yi+1, zi)], xf-1, yf-1, zf)
+		n001 := dot(perlinGradients[hash(xi, yi, zi+1)], xf, yf, zf-1)
+		n101 := dot(perlinGradients[hash(xi+1, yi, zi+1)], xf-1, yf, zf-1)
+		n011 := dot(perlinGradients[hash(xi, yi+1, zi+1)], xf, yf-1, zf-1)
+		n111 := dot(perlinGradients[hash(xi+1, yi+1, zi+1)], xf-1, yf-1, zf-1)
+
+		nx00 := lerp(n000, n100, u)
+		nx10 := lerp(n010, n110, u)
+		nx01 := lerp(n001, n101, u)
+		nx11 := lerp(n011, n111, u)
+
+		nxy0 := lerp(nx00, nx10, v)
+		nxy1 := lerp(nx01, nx11, v)
+
+		return lerp(nxy0, nxy1, w)
+	}
+}
+
+// newPermutationTable builds a 512-entry permutation table (0-255 repeated twice) shuffled
+// deterministically from seed, used to hash lattice coordinates to a gradient index.
+func newPermutationTable(seed int64) [512]int {
+	var base [256]int
+	for i := range base {
+		base[i] = i
+	}
+
+	r := rand.New(rand.NewSource(seed))
+	r.Shuffle(len(base), func(i, j int) {
+		base[i], base[j] = base[j], base[i]
+	})
+
+	var perm [512]int
+	for i := range perm {
+		perm[i] = base[i&255]
+	}
+
+	return perm
+}
+
+// fade is Perlin's quintic ease curve 6t^5 - 15t^4 + 10t^3, used so interpolation has zero first and
+// second derivatives at lattice points.
+func fade(t float32) float32 {
+	return t * t * t * (t*(t*6-15) + 10)
+}
+
+// lerp linearly interpolates between a and b by t.
+func lerp(a, b, t float32) float32 {
+	return a + t*(b-a)
+}
@@ -0,0 +1,53 @@
+package core
+
+// Material describes how a voxel's material ID should be shaded: a display name plus the PBR
+// parameters the OpenGL renderer needs to shade it.
+type Material struct {
+	Name      string
+	Color     [3]float32
+	Roughness float32
+	Metallic  float32
+}
+
+// MaterialPalette maps a VoxelGrid's per-voxel Material IDs to shading parameters. The zero value is
+// an empty palette ready to use.
+type MaterialPalette struct {
+	materials []Material
+}
+
+// NewMaterialPalette returns an empty material palette.
+func NewMaterialPalette() *MaterialPalette {
+	return &MaterialPalette{}
+}
+
+// Add registers a material and returns the ID voxels should reference it by.
+func (p *MaterialPalette) Add(m Material) uint16 {
+	p.materials = append(p.materials, m)
+	return uint16(len(p.materials) - 1)
+}
+
+// Lookup returns the material registered under id, or false if no such material exists.
+func (p *MaterialPalette) Lookup(id uint16) (Material, bool) {
+	if int(id) >= len(p.materials) {
+		return Material{}, false
+	}
+	return p.materials[id], true
+}
+
+// Len returns the number of materials registered in the palette.
+func (p *MaterialPalette) Len() int {
+	return len(p.materials)
+}
+
+// Palette returns the grid's material palette, creating an empty one on first use.
+func (vg *VoxelGrid) Palette() *MaterialPalette {
+	if vg.palette == nil {
+		vg.palette = NewMaterialPalette()
+	}
+	return vg.palette
+}
+
+// SetPalette replaces the grid's material palette.
+func (vg *VoxelGrid) SetPalette(p *MaterialPalette) {
+	vg.palette = p
+}
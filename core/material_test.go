@@ -0,0 +1,35 @@
+package core
+
+import "testing"
+
+// TestMaterialPaletteAddAndLookup checks that Add returns sequential IDs and that Lookup returns the
+// registered material by ID, or false past the end of the palette.
+func TestMaterialPaletteAddAndLookup(t *testing.T) {
+	p := NewMaterialPalette()
+
+	stoneID := p.Add(Material{Name: "Stone", Color: [3]float32{0.5, 0.5, 0.5}})
+	grassID := p.Add(Material{Name: "Grass", Color: [3]float32{0.1, 0.6, 0.1}})
+
+	if stoneID != 0 || grassID != 1 {
+		t.Fatalf("expected sequential IDs 0, 1, got %d, %d", stoneID, grassID)
+	}
+
+	if m, ok := p.Lookup(grassID); !ok || m.Name != "Grass" {
+		t.Errorf("expected to find Grass at ID %d, got %v, %v", grassID, m, ok)
+	}
+
+	if _, ok := p.Lookup(99); ok {
+		t.Error("expected Lookup to fail for an ID past the end of the palette")
+	}
+}
+
+// TestVoxelGridPaletteLazyInit checks that a grid's Palette is created empty on first access and is
+// shared across subsequent calls.
+func TestVoxelGridPaletteLazyInit(t *testing.T) {
+	vg := NewVoxelGrid(1, 1, 1, 1)
+
+	vg.Palette().Add(Material{Name: "Stone"})
+	if got := vg.Palette().Len(); got != 1 {
+		t.Errorf("expected the palette to retain the added material, got %d entries", got)
+	}
+}
@@ -0,0 +1,98 @@
+package nav
+
+// openHeap is a binary min-heap of voxels ordered by f-score, indexed by each voxel's dense linear
+// index so FindPath can decrease a voxel's key in O(log n) instead of scanning for it.
+type openHeap struct {
+	items []heapItem
+	pos   []int // linear index -> position in items, or -1 if not present
+}
+
+type heapItem struct {
+	coord [3]uint
+	idx   uint
+	f     float32
+}
+
+func newOpenHeap(volume uint) *openHeap {
+	pos := make([]int, volume)
+	for i := range pos {
+		pos[i] = -1
+	}
+	return &openHeap{pos: pos}
+}
+
+func (h *openHeap) Len() int { return len(h.items) }
+
+func (h *openHeap) contains(idx uint) bool {
+	return h.pos[idx] >= 0
+}
+
+// push adds a new voxel to the heap, or decreases its key if it's already present with a higher f.
+func (h *openHeap) push(coord [3]uint, idx uint, f float32) {
+	if p := h.pos[idx]; p >= 0 {
+		if f < h.items[p].f {
+			h.items[p].f = f
+			h.siftUp(p)
+		}
+		return
+	}
+
+	h.items = append(h.items, heapItem{coord: coord, idx: idx, f: f})
+	p := len(h.items) - 1
+	h.pos[idx] = p
+	h.siftUp(p)
+}
+
+// pop removes and returns the voxel with the lowest f-score.
+func (h *openHeap) pop() ([3]uint, uint) {
+	top := h.items[0]
+	last := len(h.items) - 1
+
+	h.swap(0, last)
+	h.items = h.items[:last]
+	h.pos[top.idx] = -1
+
+	if len(h.items) > 0 {
+		h.siftDown(0)
+	}
+
+	return top.coord, top.idx
+}
+
+func (h *openHeap) swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+	h.pos[h.items[i].idx] = i
+	h.pos[h.items[j].idx] = j
+}
+
+func (h *openHeap) siftUp(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if h.items[parent].f <= h.items[i].f {
+			break
+		}
+		h.swap(parent, i)
+		i = parent
+	}
+}
+
+func (h *openHeap) siftDown(i int) {
+	n := len(h.items)
+	for {
+		left, right := 2*i+1, 2*i+2
+		smallest := i
+
+		if left < n && h.items[left].f < h.items[smallest].f {
+			smallest = left
+		}
+		if right < n && h.items[right].f < h.items[smallest].f {
+			smallest = right
+		}
+		if smallest == i {
+			break
+		}
+
+		h.swap(smallest, i)
+		i = smallest
+	}
+}
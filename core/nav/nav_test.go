@@ -0,0 +1,122 @@
+package nav
+
+import (
+	"testing"
+
+	"github.com/Glenn-Gray-Labs/OpenPAF/core"
+)
+
+// TestFindPathStraightLine checks that an open grid with no obstacles produces a path from start to
+// goal inclusive.
+func TestFindPathStraightLine(t *testing.T) {
+	vg := core.NewBoundedVoxelGrid([3]float32{0, 0, 0}, [3]float32{1, 1, 1}, 10)
+	ng := NewNavGrid(vg, 0.5)
+
+	path, ok := ng.FindPath([3]uint{0, 0, 0}, [3]uint{9, 0, 0})
+	if !ok {
+		t.Fatal("expected a path across an open grid")
+	}
+	if got, want := path[0], ([3]uint{0, 0, 0}); got != want {
+		t.Errorf("expected path to start at %v, got %v", want, got)
+	}
+	if got, want := path[len(path)-1], ([3]uint{9, 0, 0}); got != want {
+		t.Errorf("expected path to end at %v, got %v", want, got)
+	}
+}
+
+// TestFindPathBlockedWall checks that a wall of blocked voxels spanning the grid's width makes the
+// goal unreachable.
+func TestFindPathBlockedWall(t *testing.T) {
+	vg := core.NewBoundedVoxelGrid([3]float32{0, 0, 0}, [3]float32{1, 1, 1}, 5)
+	for y := uint(0); y < 5; y++ {
+		for z := uint(0); z < 5; z++ {
+			vg.Set(2, y, z, core.Voxel{Density: 1})
+		}
+	}
+
+	ng := NewNavGrid(vg, 0.5)
+
+	if _, ok := ng.FindPath([3]uint{0, 0, 0}, [3]uint{4, 0, 0}); ok {
+		t.Error("expected no path through a wall spanning the grid")
+	}
+}
+
+// TestFindPathGoesAroundObstacle checks that a partial obstacle with a gap still yields a path.
+func TestFindPathGoesAroundObstacle(t *testing.T) {
+	vg := core.NewBoundedVoxelGrid([3]float32{0, 0, 0}, [3]float32{1, 1, 1}, 5)
+	for y := uint(0); y < 4; y++ {
+		vg.Set(2, y, 0, core.Voxel{Density: 1})
+	}
+
+	ng := NewNavGrid(vg, 0.5)
+
+	path, ok := ng.FindPath([3]uint{0, 0, 0}, [3]uint{4, 0, 0})
+	if !ok {
+		t.Fatal("expected a path around the partial wall")
+	}
+	for _, c := range path {
+		if c == ([3]uint{2, 0, 0}) || c == ([3]uint{2, 1, 0}) || c == ([3]uint{2, 2, 0}) || c == ([3]uint{2, 3, 0}) {
+			t.Fatalf("path passes through blocked voxel %v", c)
+		}
+	}
+}
+
+// TestFindPathWorldRoundTrips checks that FindPathWorld snaps world-space endpoints to voxels and
+// returns a world-space path of the same length as the voxel-space equivalent.
+func TestFindPathWorldRoundTrips(t *testing.T) {
+	vg := core.NewBoundedVoxelGrid([3]float32{0, 0, 0}, [3]float32{1, 1, 1}, 10)
+	ng := NewNavGrid(vg, 0.5)
+
+	voxelPath, ok := ng.FindPath([3]uint{0, 0, 0}, [3]uint{9, 0, 0})
+	if !ok {
+		t.Fatal("expected a voxel-space path")
+	}
+
+	worldPath, ok := ng.FindPathWorld(vg.VoxelToWorld(0, 0, 0), vg.VoxelToWorld(9, 0, 0))
+	if !ok {
+		t.Fatal("expected a world-space path")
+	}
+	if len(worldPath) != len(voxelPath) {
+		t.Errorf("expected world path length %d to match voxel path length %d", len(worldPath), len(voxelPath))
+	}
+}
+
+// TestReachableFloodFill checks that Reachable marks only the voxels connected to the start point,
+// stopping at a dividing wall.
+func TestReachableFloodFill(t *testing.T) {
+	vg := core.NewBoundedVoxelGrid([3]float32{0, 0, 0}, [3]float32{1, 1, 1}, 5)
+	for y := uint(0); y < 5; y++ {
+		for z := uint(0); z < 5; z++ {
+			vg.Set(2, y, z, core.Voxel{Density: 1})
+		}
+	}
+
+	ng := NewNavGrid(vg, 0.5)
+	reachable := ng.Reachable([3]uint{0, 0, 0})
+
+	if v := reachable.Get(0, 0, 0); v.Density != 1 {
+		t.Errorf("expected the start voxel to be marked reachable, got %v", v)
+	}
+	if v := reachable.Get(4, 0, 0); v.Density != 0 {
+		t.Errorf("expected voxels beyond the wall to be unreachable, got %v", v)
+	}
+}
+
+// TestDebugMeshReflectsLastSearch checks that DebugMesh reports non-empty path and closed-set geometry
+// after a successful search.
+func TestDebugMeshReflectsLastSearch(t *testing.T) {
+	vg := core.NewBoundedVoxelGrid([3]float32{0, 0, 0}, [3]float32{1, 1, 1}, 5)
+	ng := NewNavGrid(vg, 0.5)
+
+	if _, ok := ng.FindPath([3]uint{0, 0, 0}, [3]uint{4, 0, 0}); !ok {
+		t.Fatal("expected a path across an open grid")
+	}
+
+	path, _, closed := ng.DebugMesh()
+	if len(path) == 0 {
+		t.Error("expected DebugMesh to report the found path")
+	}
+	if len(closed) == 0 {
+		t.Error("expected DebugMesh to report the closed set explored during the search")
+	}
+}
@@ -0,0 +1,204 @@
+// Package nav treats a core.VoxelGrid as a navigation volume, providing A* pathfinding, connectivity
+// queries, and debug visualization geometry over it.
+package nav
+
+import (
+	math32 "github.com/chewxy/math32"
+
+	"github.com/Glenn-Gray-Labs/OpenPAF/core"
+)
+
+// Connectivity selects which neighboring voxels a search step is allowed to move to.
+type Connectivity int
+
+const (
+	// Connectivity6 allows movement only along the 6 face-adjacent neighbors.
+	Connectivity6 Connectivity = iota
+	// Connectivity18 additionally allows the 12 edge-adjacent neighbors.
+	Connectivity18
+	// Connectivity26 additionally allows the 8 corner-adjacent neighbors.
+	Connectivity26
+)
+
+// sqrt2 and sqrt3 are the diagonal-step distances for 2-axis and 3-axis moves respectively, used by
+// the default cost function and the octile heuristic.
+const (
+	sqrt2 = 1.41421356
+	sqrt3 = 1.73205081
+)
+
+// Heuristic selects the distance estimate FindPath uses to guide its search toward the goal.
+type Heuristic int
+
+const (
+	// HeuristicEuclidean estimates remaining cost as straight-line distance.
+	HeuristicEuclidean Heuristic = iota
+	// HeuristicOctile estimates remaining cost assuming 2-axis diagonal moves cost sqrt2 and 3-axis
+	// diagonal moves cost sqrt3, which matches Connectivity18/Connectivity26 better than Euclidean does.
+	HeuristicOctile
+)
+
+// CostFunc weights the cost of moving from one voxel to an adjacent one. The default, used when no
+// CostFunc is set, is a uniform cost of 1 per axis-aligned step and sqrt2/sqrt3 per diagonal step.
+type CostFunc func(from, to [3]uint) float32
+
+// NavGrid adapts a core.VoxelGrid into a navigation volume: voxels at or above threshold are blocked,
+// all others are walkable.
+type NavGrid struct {
+	vg           *core.VoxelGrid
+	threshold    float32
+	connectivity Connectivity
+	heuristic    Heuristic
+	costFunc     CostFunc
+
+	dims [3]uint
+
+	lastOpen, lastClosed [][3]uint
+	lastPath             [][3]uint
+}
+
+// NewNavGrid builds a NavGrid over vg. A voxel is blocked when its value is >= threshold. The grid
+// defaults to 6-connectivity and a Euclidean heuristic; use WithConnectivity, WithHeuristic, and
+// WithCostFunc to change them.
+func NewNavGrid(vg *core.VoxelGrid, threshold float32) *NavGrid {
+	return &NavGrid{
+		vg:           vg,
+		threshold:    threshold,
+		connectivity: Connectivity6,
+		heuristic:    HeuristicEuclidean,
+		dims:         vg.Dimensions(),
+	}
+}
+
+// WithConnectivity sets the neighbor connectivity FindPath searches with and returns ng for chaining.
+func (ng *NavGrid) WithConnectivity(c Connectivity) *NavGrid {
+	ng.connectivity = c
+	return ng
+}
+
+// WithHeuristic sets the distance heuristic FindPath searches with and returns ng for chaining.
+func (ng *NavGrid) WithHeuristic(h Heuristic) *NavGrid {
+	ng.heuristic = h
+	return ng
+}
+
+// WithCostFunc sets a custom per-step cost function, letting callers weight terrain (e.g. by voxel
+// intensity), and returns ng for chaining.
+func (ng *NavGrid) WithCostFunc(fn CostFunc) *NavGrid {
+	ng.costFunc = fn
+	return ng
+}
+
+// Walkable reports whether the voxel at x, y, z is within bounds and below the blocked threshold.
+func (ng *NavGrid) Walkable(x, y, z uint) bool {
+	if x >= ng.dims[0] || y >= ng.dims[1] || z >= ng.dims[2] {
+		return false
+	}
+	return ng.vg.Get(x, y, z).Density < ng.threshold
+}
+
+// linearIndex maps a voxel coordinate to a dense index in [0, volume), matching the grid's internal
+// x-fastest layout so the open-set heap can be indexed directly by it.
+func (ng *NavGrid) linearIndex(c [3]uint) uint {
+	return c[0] + ng.dims[0]*(c[1]+ng.dims[1]*c[2])
+}
+
+func (ng *NavGrid) volume() uint {
+	return ng.dims[0] * ng.dims[1] * ng.dims[2]
+}
+
+// neighbors appends the walkable neighbors of c (per ng.connectivity) to dst and returns the result.
+func (ng *NavGrid) neighbors(c [3]uint, dst [][3]uint) [][3]uint {
+	for dz := -1; dz <= 1; dz++ {
+		for dy := -1; dy <= 1; dy++ {
+			for dx := -1; dx <= 1; dx++ {
+				if dx == 0 && dy == 0 && dz == 0 {
+					continue
+				}
+
+				manhattan := abs(dx) + abs(dy) + abs(dz)
+				switch ng.connectivity {
+				case Connectivity6:
+					if manhattan != 1 {
+						continue
+					}
+				case Connectivity18:
+					if manhattan > 2 {
+						continue
+					}
+				}
+				// Connectivity26 allows every offset in the 3x3x3 neighborhood.
+
+				nx, ny, nz := int(c[0])+dx, int(c[1])+dy, int(c[2])+dz
+				if nx < 0 || ny < 0 || nz < 0 {
+					continue
+				}
+
+				n := [3]uint{uint(nx), uint(ny), uint(nz)}
+				if !ng.Walkable(n[0], n[1], n[2]) {
+					continue
+				}
+
+				dst = append(dst, n)
+			}
+		}
+	}
+
+	return dst
+}
+
+// stepCost returns the cost of moving from a to its neighbor b, using ng.costFunc if set or a uniform
+// cost scaled by the number of diagonal axes otherwise.
+func (ng *NavGrid) stepCost(a, b [3]uint) float32 {
+	if ng.costFunc != nil {
+		return ng.costFunc(a, b)
+	}
+
+	diagonalAxes := 0
+	for i := 0; i < 3; i++ {
+		if a[i] != b[i] {
+			diagonalAxes++
+		}
+	}
+
+	switch diagonalAxes {
+	case 2:
+		return sqrt2
+	case 3:
+		return sqrt3
+	default:
+		return 1
+	}
+}
+
+// heuristicCost estimates the remaining cost from a to b per ng.heuristic.
+func (ng *NavGrid) heuristicCost(a, b [3]uint) float32 {
+	dx := math32.Abs(float32(a[0]) - float32(b[0]))
+	dy := math32.Abs(float32(a[1]) - float32(b[1]))
+	dz := math32.Abs(float32(a[2]) - float32(b[2]))
+
+	if ng.heuristic == HeuristicOctile {
+		d := []float32{dx, dy, dz}
+		// sort d ascending so d[0] <= d[1] <= d[2]
+		if d[0] > d[1] {
+			d[0], d[1] = d[1], d[0]
+		}
+		if d[1] > d[2] {
+			d[1], d[2] = d[2], d[1]
+		}
+		if d[0] > d[1] {
+			d[0], d[1] = d[1], d[0]
+		}
+
+		return (sqrt3-sqrt2)*d[0] + (sqrt2-1)*d[1] + d[2]
+	}
+
+	return math32.Sqrt(dx*dx + dy*dy + dz*dz)
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
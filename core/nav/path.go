@@ -0,0 +1,175 @@
+package nav
+
+import "github.com/Glenn-Gray-Labs/OpenPAF/core"
+
+// FindPath searches for a path from start to goal using A* with ng's configured connectivity,
+// heuristic, and cost function. It returns the path as a sequence of voxel coordinates from start to
+// goal inclusive, and false if start or goal is blocked/out of bounds or no path exists. The open and
+// closed sets explored are recorded for DebugMesh.
+func (ng *NavGrid) FindPath(start, goal [3]uint) ([][3]uint, bool) {
+	ng.lastPath, ng.lastOpen, ng.lastClosed = nil, nil, nil
+
+	if !ng.Walkable(start[0], start[1], start[2]) || !ng.Walkable(goal[0], goal[1], goal[2]) {
+		return nil, false
+	}
+
+	volume := ng.volume()
+	startIdx, goalIdx := ng.linearIndex(start), ng.linearIndex(goal)
+
+	g := make([]float32, volume)
+	visited := make([]bool, volume)
+	closed := make([]bool, volume)
+	cameFrom := make([]uint, volume)
+	hasCameFrom := make([]bool, volume)
+
+	open := newOpenHeap(volume)
+	g[startIdx] = 0
+	visited[startIdx] = true
+	open.push(start, startIdx, ng.heuristicCost(start, goal))
+
+	var neighborBuf [][3]uint
+
+	for open.Len() > 0 {
+		current, currentIdx := open.pop()
+		if closed[currentIdx] {
+			continue
+		}
+		closed[currentIdx] = true
+		ng.lastClosed = append(ng.lastClosed, current)
+
+		if currentIdx == goalIdx {
+			return ng.reconstructPath(cameFrom, hasCameFrom, start, goal), true
+		}
+
+		neighborBuf = ng.neighbors(current, neighborBuf[:0])
+		for _, n := range neighborBuf {
+			nIdx := ng.linearIndex(n)
+			if closed[nIdx] {
+				continue
+			}
+
+			tentativeG := g[currentIdx] + ng.stepCost(current, n)
+			if visited[nIdx] && tentativeG >= g[nIdx] {
+				continue
+			}
+
+			visited[nIdx] = true
+			g[nIdx] = tentativeG
+			cameFrom[nIdx] = currentIdx
+			hasCameFrom[nIdx] = true
+
+			f := tentativeG + ng.heuristicCost(n, goal)
+			open.push(n, nIdx, f)
+			ng.lastOpen = append(ng.lastOpen, n)
+		}
+	}
+
+	return nil, false
+}
+
+// reconstructPath walks cameFrom from goal back to start and reverses the result into start->goal order.
+func (ng *NavGrid) reconstructPath(cameFrom []uint, hasCameFrom []bool, start, goal [3]uint) [][3]uint {
+	path := [][3]uint{goal}
+
+	idx := ng.linearIndex(goal)
+	for hasCameFrom[idx] {
+		idx = cameFrom[idx]
+		x, y, z := ng.coordFromIndex(idx)
+		path = append(path, [3]uint{x, y, z})
+	}
+
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+
+	ng.lastPath = path
+	return path
+}
+
+func (ng *NavGrid) coordFromIndex(idx uint) (x, y, z uint) {
+	x = idx % ng.dims[0]
+	rest := idx / ng.dims[0]
+	y = rest % ng.dims[1]
+	z = rest / ng.dims[1]
+	return
+}
+
+// FindPathWorld is FindPath for world-space positions: it snaps start and goal to voxels via the
+// grid's world<->voxel conversion and returns the resulting path as world-space cell centers.
+func (ng *NavGrid) FindPathWorld(start, goal [3]float32) ([][3]float32, bool) {
+	sx, sy, sz, startInside := ng.vg.WorldToVoxel(start)
+	gx, gy, gz, goalInside := ng.vg.WorldToVoxel(goal)
+	if !startInside || !goalInside {
+		return nil, false
+	}
+
+	path, ok := ng.FindPath([3]uint{sx, sy, sz}, [3]uint{gx, gy, gz})
+	if !ok {
+		return nil, false
+	}
+
+	worldPath := make([][3]float32, len(path))
+	for i, c := range path {
+		worldPath[i] = ng.vg.VoxelToWorld(c[0], c[1], c[2])
+	}
+
+	return worldPath, true
+}
+
+// Reachable flood-fills the walkable voxels connected to from and returns a voxel grid the same size
+// as ng's, with 1 marking reachable voxels and 0 marking everything else -- useful for connectivity
+// queries like "can this region be reached at all".
+func (ng *NavGrid) Reachable(from [3]uint) *core.VoxelGrid {
+	min, max := ng.vg.Bounds()
+	result := core.NewBoundedVoxelGrid(min, max, ng.vg.Resolution())
+
+	if !ng.Walkable(from[0], from[1], from[2]) {
+		return result
+	}
+
+	volume := ng.volume()
+	visited := make([]bool, volume)
+	stack := [][3]uint{from}
+	visited[ng.linearIndex(from)] = true
+
+	var neighborBuf [][3]uint
+	for len(stack) > 0 {
+		c := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		result.Set(c[0], c[1], c[2], core.Voxel{Density: 1})
+
+		neighborBuf = ng.neighbors(c, neighborBuf[:0])
+		for _, n := range neighborBuf {
+			nIdx := ng.linearIndex(n)
+			if visited[nIdx] {
+				continue
+			}
+			visited[nIdx] = true
+			stack = append(stack, n)
+		}
+	}
+
+	return result
+}
+
+// DebugMesh returns vertex points for the most recent FindPath call's resulting path, open set, and
+// closed set (in that order), in world space, so the editor can visualize a search in progress.
+func (ng *NavGrid) DebugMesh() (path, open, closed [][3]float32) {
+	path = make([][3]float32, len(ng.lastPath))
+	for i, c := range ng.lastPath {
+		path[i] = ng.vg.VoxelToWorld(c[0], c[1], c[2])
+	}
+
+	open = make([][3]float32, len(ng.lastOpen))
+	for i, c := range ng.lastOpen {
+		open[i] = ng.vg.VoxelToWorld(c[0], c[1], c[2])
+	}
+
+	closed = make([][3]float32, len(ng.lastClosed))
+	for i, c := range ng.lastClosed {
+		closed[i] = ng.vg.VoxelToWorld(c[0], c[1], c[2])
+	}
+
+	return path, open, closed
+}
@@ -0,0 +1,136 @@
+package core
+
+import "fmt"
+
+// packedVoxel is the on-disk representation PackedVoxelGrid stores per voxel: density quantized to a
+// byte and a single-byte material ID, for 2 bytes/voxel instead of Voxel's ~8.
+type packedVoxel struct {
+	density  uint8
+	material uint8
+}
+
+// PackedVoxelGrid is a memory-compact alternative to VoxelGrid for large grids: it stores density as a
+// quantized uint8 and material as a uint8 (2 bytes/voxel) rather than a full Voxel struct, converting
+// to and from Voxel on Get/Set. It does not carry per-voxel Flags or materials beyond 256 entries.
+type PackedVoxelGrid struct {
+	resolution uint
+	counts     [3]uint
+	voxels     []packedVoxel
+	min, max   [3]float32
+	palette    *MaterialPalette
+}
+
+// NewPackedVoxelGrid configures a packed voxel grid over the same world-space bounds and resolution
+// conventions as NewBoundedVoxelGrid.
+func NewPackedVoxelGrid(min, max [3]float32, resolution uint) *PackedVoxelGrid {
+	vg := NewBoundedVoxelGrid(min, max, resolution)
+	return &PackedVoxelGrid{
+		resolution: resolution,
+		counts:     vg.counts,
+		voxels:     make([]packedVoxel, vg.counts[0]*vg.counts[1]*vg.counts[2]),
+		min:        vg.min,
+		max:        vg.max,
+	}
+}
+
+func (pg *PackedVoxelGrid) getIndex(x, y, z uint) uint {
+	w, h, d := pg.counts[0], pg.counts[1], pg.counts[2]
+
+	if x >= w || y >= h || z >= d {
+		panic(fmt.Errorf("x, y, z coordinate of %d, %d, %d exceed max voxel dimensions of %d, %d, %d", x, y, z, w, h, d))
+	}
+
+	return (w * h * z) + (w * y) + x
+}
+
+// Get returns the voxel stored at the given 3D coordinates, expanding its quantized density and
+// material back to a full Voxel. The Flags bits are always zero, since PackedVoxelGrid doesn't store
+// them.
+func (pg *PackedVoxelGrid) Get(x, y, z uint) Voxel {
+	p := pg.voxels[pg.getIndex(x, y, z)]
+	return Voxel{
+		Density:  float32(p.density) / 255,
+		Material: uint16(p.material),
+	}
+}
+
+// Set quantizes vox's density to a byte and truncates its material to a byte before storing it; Flags
+// are discarded.
+func (pg *PackedVoxelGrid) Set(x, y, z uint, vox Voxel) {
+	pg.voxels[pg.getIndex(x, y, z)] = packedVoxel{
+		density:  quantizeDensity(vox.Density),
+		material: uint8(vox.Material),
+	}
+}
+
+// Dimensions returns the grid's size in voxels along each axis.
+func (pg *PackedVoxelGrid) Dimensions() [3]uint {
+	return pg.counts
+}
+
+// Bounds returns the world-space axis-aligned bounding box the grid occupies.
+func (pg *PackedVoxelGrid) Bounds() (min, max [3]float32) {
+	return pg.min, pg.max
+}
+
+// Palette returns the grid's material palette, creating an empty one on first use.
+func (pg *PackedVoxelGrid) Palette() *MaterialPalette {
+	if pg.palette == nil {
+		pg.palette = NewMaterialPalette()
+	}
+	return pg.palette
+}
+
+// SetPalette replaces the grid's material palette.
+func (pg *PackedVoxelGrid) SetPalette(p *MaterialPalette) {
+	pg.palette = p
+}
+
+// quantizeDensity clamps v to [0, 1] and rounds it to the nearest representable uint8 density.
+func quantizeDensity(v float32) uint8 {
+	if v <= 0 {
+		return 0
+	}
+	if v >= 1 {
+		return 255
+	}
+	return uint8(v*255 + 0.5)
+}
+
+// Pack converts vg to its compact PackedVoxelGrid representation, quantizing each voxel's density and
+// truncating its material; Flags are discarded. The grid's material palette, if any, is carried over.
+func (vg *VoxelGrid) Pack() *PackedVoxelGrid {
+	pg := NewPackedVoxelGrid(vg.min, vg.max, vg.resolution)
+	pg.palette = vg.palette
+
+	for i, v := range vg.voxels {
+		pg.voxels[i] = packedVoxel{
+			density:  quantizeDensity(v.Density),
+			material: uint8(v.Material),
+		}
+	}
+
+	return pg
+}
+
+// Unpack expands pg back into a full VoxelGrid, restoring its quantized density and material into a
+// Voxel per cell; Flags come back zeroed, since PackedVoxelGrid never stored them.
+func (pg *PackedVoxelGrid) Unpack() *VoxelGrid {
+	vg := &VoxelGrid{
+		resolution: pg.resolution,
+		counts:     pg.counts,
+		voxels:     make([]Voxel, len(pg.voxels)),
+		min:        pg.min,
+		max:        pg.max,
+		palette:    pg.palette,
+	}
+
+	for i, p := range pg.voxels {
+		vg.voxels[i] = Voxel{
+			Density:  float32(p.density) / 255,
+			Material: uint16(p.material),
+		}
+	}
+
+	return vg
+}
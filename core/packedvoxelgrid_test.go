@@ -0,0 +1,47 @@
+package core
+
+import "testing"
+
+// TestPackedVoxelGridGetSetRoundTrips checks that Set followed by Get recovers density (within
+// quantization error) and material, with Flags always reading back as zero.
+func TestPackedVoxelGridGetSetRoundTrips(t *testing.T) {
+	pg := NewPackedVoxelGrid([3]float32{0, 0, 0}, [3]float32{1, 1, 1}, 4)
+	pg.Set(1, 2, 3, Voxel{Density: 0.75, Material: 5, Flags: 1})
+
+	got := pg.Get(1, 2, 3)
+	if diff := got.Density - 0.75; diff > 0.01 || diff < -0.01 {
+		t.Errorf("expected density near 0.75, got %v", got.Density)
+	}
+	if got.Material != 5 {
+		t.Errorf("expected material 5, got %v", got.Material)
+	}
+	if got.Flags != 0 {
+		t.Errorf("expected flags to be discarded, got %v", got.Flags)
+	}
+}
+
+// TestVoxelGridPackUnpackRoundTrips checks that Pack followed by Unpack preserves a VoxelGrid's bounds,
+// dimensions, and voxel density/material (within quantization error).
+func TestVoxelGridPackUnpackRoundTrips(t *testing.T) {
+	vg := NewBoundedVoxelGrid([3]float32{0, 0, 0}, [3]float32{1, 1, 1}, 4)
+	vg.Set(0, 0, 0, Voxel{Density: 0.5, Material: 2})
+
+	unpacked := vg.Pack().Unpack()
+
+	wantMin, wantMax := vg.Bounds()
+	gotMin, gotMax := unpacked.Bounds()
+	if gotMin != wantMin || gotMax != wantMax {
+		t.Errorf("expected bounds %v/%v, got %v/%v", wantMin, wantMax, gotMin, gotMax)
+	}
+	if unpacked.Dimensions() != vg.Dimensions() {
+		t.Errorf("expected dimensions %v, got %v", vg.Dimensions(), unpacked.Dimensions())
+	}
+
+	got := unpacked.Get(0, 0, 0)
+	if diff := got.Density - 0.5; diff > 0.01 || diff < -0.01 {
+		t.Errorf("expected density near 0.5, got %v", got.Density)
+	}
+	if got.Material != 2 {
+		t.Errorf("expected material 2, got %v", got.Material)
+	}
+}
@@ -1,6 +1,11 @@
 package core
 
 // Voxel represents a volumetric pixel, or a single point in 3d space that contains a value and
-// potentially some additional information or data.
-// TODO: make this a struct so we can store more information
-type Voxel float32
\ No newline at end of file
+// potentially some additional information or data. Density drives the scalar field sampled by Mesh
+// and VertexPoints; Material indexes into a VoxelGrid's MaterialPalette; Flags is a bitfield reserved
+// for per-voxel metadata (e.g. marking a voxel as user-locked or non-destructible).
+type Voxel struct {
+	Density  float32
+	Material uint16
+	Flags    uint16
+}
@@ -8,22 +8,51 @@ import (
 
 // VoxelGrid is a 3-dimensional grid of voxels based on a given set of dimensions and a resolution per
 // dimensional unit. The purpose of this type is only to manage the contents of a collection of voxels.
+// Every grid carries an axis-aligned bounding box (min, max) that anchors it in world space, so that
+// grids placed at different origins or scales can be composed into a single scene.
 type VoxelGrid struct {
 	resolution uint
-	counts     [3]uint // dimensions in number of voxels
-	voxels     []Voxel // the stored voxels for the grid
+	counts     [3]uint    // dimensions in number of voxels
+	voxels     []Voxel    // the stored voxels for the grid
+	min, max   [3]float32 // the world-space bounds the grid occupies
+	palette    *MaterialPalette
 }
 
 // NewVoxelGrid calculates the requirements for a voxel grid, configures one, and then returns the result.
+// The grid is anchored at the world-space origin.
 func NewVoxelGrid(width, height, depth float32, resolution uint) *VoxelGrid {
 	w := uint(math32.Ceil(math32.Abs(width * float32(resolution))))
 	h := uint(math32.Ceil(math32.Abs(height * float32(resolution))))
 	d := uint(math32.Ceil(math32.Abs(depth * float32(resolution))))
 
+	res := float32(resolution)
 	return &VoxelGrid{
 		resolution: resolution,
 		counts:     [3]uint{w, h, d},
 		voxels:     make([]Voxel, w*h*d),
+		min:        [3]float32{0, 0, 0},
+		max:        [3]float32{float32(w) / res, float32(h) / res, float32(d) / res},
+	}
+}
+
+// NewBoundedVoxelGrid configures a voxel grid anchored to an explicit world-space axis-aligned bounding
+// box rather than the origin, so that multiple grids can be composed at different positions and scales
+// within the same scene. resolution is in voxels per world unit, uniform across all three axes; the
+// actual max bound is snapped outward to a whole number of voxels, mirroring NewVoxelGrid.
+func NewBoundedVoxelGrid(min, max [3]float32, resolution uint) *VoxelGrid {
+	size := [3]float32{max[0] - min[0], max[1] - min[1], max[2] - min[2]}
+
+	w := uint(math32.Ceil(math32.Abs(size[0] * float32(resolution))))
+	h := uint(math32.Ceil(math32.Abs(size[1] * float32(resolution))))
+	d := uint(math32.Ceil(math32.Abs(size[2] * float32(resolution))))
+
+	res := float32(resolution)
+	return &VoxelGrid{
+		resolution: resolution,
+		counts:     [3]uint{w, h, d},
+		voxels:     make([]Voxel, w*h*d),
+		min:        min,
+		max:        [3]float32{min[0] + float32(w)/res, min[1] + float32(h)/res, min[2] + float32(d)/res},
 	}
 }
 
@@ -67,63 +96,247 @@ func (vg *VoxelGrid) Copy() *VoxelGrid {
 	return newGrid
 }
 
-// Fill sets all of the voxels in the grid to same the voxel value.
+// Fill sets the density of every voxel in the grid to the same value, clearing their material and flags.
 func (vg *VoxelGrid) Fill(value float32) *VoxelGrid {
 	value = math32.Min(1, math32.Max(0, value))
 	for i := range vg.voxels {
-		vg.voxels[i] = Voxel(value)
+		vg.voxels[i] = Voxel{Density: value}
 	}
 
 	return vg
 }
 
-// Randomize generates a random value for each voxel in the grid. This is largely for testing purposes and
-// will eventually be implemented as a graph procedure.
+// Randomize generates a random density for each voxel in the grid, clearing their material and flags.
+// This is largely for testing purposes and will eventually be implemented as a graph procedure.
 func (vg *VoxelGrid) Randomize(seed int64) *VoxelGrid {
 	r := rand.New(rand.NewSource(seed))
 	for i := range vg.voxels {
-		vg.voxels[i] = Voxel(r.Float32())
+		vg.voxels[i] = Voxel{Density: r.Float32()}
 	}
 
 	return vg
 }
 
-// HighPass runs over each voxel in the grid and then sets the voxel's value to either 0 or 1 depending on
-// whether or not its within the given range of tolerance. This will eventually be implemented as a graph procedure.
+// HighPass runs over each voxel in the grid and then sets the voxel's density to either 0 or 1 depending
+// on whether or not its within the given range of tolerance, leaving material and flags untouched. This
+// will eventually be implemented as a graph procedure.
 func (vg *VoxelGrid) HighPass(tolerance float32) *VoxelGrid {
 	for i, v := range vg.voxels {
-		vg.voxels[i] = 0
-		if float32(v) > tolerance {
-			vg.voxels[i] = 1
+		vg.voxels[i].Density = 0
+		if v.Density > tolerance {
+			vg.voxels[i].Density = 1
 		}
 	}
 
 	return vg
 }
 
-// VertexPoints converts all voxels with a value greater than 0.5 to a list of 3D vertex
-// coordinates.
+// VertexPoints converts all voxels with a density of 0.5 or greater to a list of 3D vertex
+// coordinates in world space.
 func (vg *VoxelGrid) VertexPoints() [][3]float32 {
 	var points [][3]float32
 	for i, v := range vg.voxels {
-		if v < 0.5 {
+		if v.Density < 0.5 {
 			continue
 		}
 
 		x, y, z := vg.getCoordinate(uint(i))
-		res := float32(vg.resolution)
-		points = append(points, [3]float32{
-			float32(x) / res,
-			float32(y) / res,
-			float32(z) / res,
-		})
+		points = append(points, vg.cornerPosition(x, y, z))
 	}
 
 	return points
 }
 
-// Mesh attempts to perform cube march algorithm using the current voxel grid and returns the resulting
-// mesh information with the results.
-func (vg *VoxelGrid) Mesh() {
-	// TODO: implement this function
+// DefaultIsolevel is the scalar-field threshold Mesh uses when the caller doesn't need a custom one.
+const DefaultIsolevel = 0.5
+
+// Mesh runs the marching cubes algorithm over the grid at the DefaultIsolevel. See MeshIso for details.
+func (vg *VoxelGrid) Mesh() ([][3]float32, [][3]float32, []uint32) {
+	return vg.MeshIso(DefaultIsolevel)
+}
+
+// MeshIso performs the classic Marching Cubes algorithm against the voxel grid treating each voxel value
+// as a scalar field sample, and returns the resulting mesh as deduplicated vertices, their per-vertex
+// normals, and a triangle index buffer. isolevel is the scalar threshold the surface is extracted at.
+func (vg *VoxelGrid) MeshIso(isolevel float32) (vertices [][3]float32, normals [][3]float32, indices []uint32) {
+	vertices, normals, indices, _ = vg.meshIso(isolevel)
+	return
+}
+
+// MeshMaterials runs the marching cubes algorithm over the grid at the DefaultIsolevel, additionally
+// returning a per-vertex Material ID. See MeshIsoMaterials for details.
+func (vg *VoxelGrid) MeshMaterials() ([][3]float32, [][3]float32, []uint32, []uint32) {
+	return vg.MeshIsoMaterials(DefaultIsolevel)
+}
+
+// MeshIsoMaterials is MeshIso, additionally returning a per-vertex Material ID so the renderer can
+// shade the mesh by material rather than as a single flat surface. Each vertex is assigned the
+// Material of whichever of its two straddling corners sits on the solid side of the isosurface.
+func (vg *VoxelGrid) MeshIsoMaterials(isolevel float32) (vertices [][3]float32, normals [][3]float32, indices []uint32, materials []uint32) {
+	return vg.meshIso(isolevel)
+}
+
+func (vg *VoxelGrid) meshIso(isolevel float32) (vertices [][3]float32, normals [][3]float32, indices []uint32, materials []uint32) {
+	w, h, d := vg.counts[0], vg.counts[1], vg.counts[2]
+	if w < 2 || h < 2 || d < 2 {
+		return
+	}
+
+	edgeVertex := make(map[uint64]uint32)
+
+	for z := uint(0); z < d-1; z++ {
+		for y := uint(0); y < h-1; y++ {
+			for x := uint(0); x < w-1; x++ {
+				var corners [8][3]uint
+				var values [8]float32
+				cubeindex := uint8(0)
+
+				for i, offset := range mcCornerOffset {
+					corners[i] = [3]uint{x + offset[0], y + offset[1], z + offset[2]}
+					values[i] = vg.cornerValue(corners[i][0], corners[i][1], corners[i][2])
+					if values[i] < isolevel {
+						cubeindex |= 1 << uint(i)
+					}
+				}
+
+				edges := mcEdgeTable[cubeindex]
+				if edges == 0 {
+					continue
+				}
+
+				var edgeVert [12]uint32
+				for e := 0; e < 12; e++ {
+					if edges&(1<<uint(e)) == 0 {
+						continue
+					}
+
+					a, b := mcEdgeCorners[e][0], mcEdgeCorners[e][1]
+					ca, cb := corners[a], corners[b]
+					key := edgeKey(vg.getIndex(ca[0], ca[1], ca[2]), vg.getIndex(cb[0], cb[1], cb[2]))
+
+					if idx, ok := edgeVertex[key]; ok {
+						edgeVert[e] = idx
+						continue
+					}
+
+					t := (isolevel - values[a]) / (values[b] - values[a])
+					vertices = append(vertices, vg.interpPosition(ca, cb, t))
+					normals = append(normals, vg.interpNormal(ca, cb, t))
+					materials = append(materials, uint32(vg.solidMaterial(ca, cb, values[a], isolevel)))
+
+					idx := uint32(len(vertices) - 1)
+					edgeVertex[key] = idx
+					edgeVert[e] = idx
+				}
+
+				for i := 0; mcTriTable[cubeindex][i] != -1; i += 3 {
+					indices = append(indices,
+						edgeVert[mcTriTable[cubeindex][i]],
+						edgeVert[mcTriTable[cubeindex][i+1]],
+						edgeVert[mcTriTable[cubeindex][i+2]],
+					)
+				}
+			}
+		}
+	}
+
+	return
+}
+
+// solidMaterial returns the Material of whichever of corners a, b is on the solid side (at or above
+// isolevel) of the isosurface crossing between them, breaking ties toward a.
+func (vg *VoxelGrid) solidMaterial(a, b [3]uint, valueA, isolevel float32) uint16 {
+	if valueA >= isolevel {
+		return vg.Get(a[0], a[1], a[2]).Material
+	}
+	return vg.Get(b[0], b[1], b[2]).Material
+}
+
+// cornerValue returns the scalar field sample at a grid corner for marching cubes purposes.
+func (vg *VoxelGrid) cornerValue(x, y, z uint) float32 {
+	return vg.Get(x, y, z).Density
+}
+
+// cornerPosition returns the world-space position of a grid corner, honoring the grid's bounds.
+func (vg *VoxelGrid) cornerPosition(x, y, z uint) [3]float32 {
+	size := vg.VoxelSize()
+	return [3]float32{
+		vg.min[0] + float32(x)*size[0],
+		vg.min[1] + float32(y)*size[1],
+		vg.min[2] + float32(z)*size[2],
+	}
+}
+
+// cornerGradient estimates the scalar field gradient at a grid corner using central differences,
+// falling back to a one-sided difference at the edges of the grid.
+func (vg *VoxelGrid) cornerGradient(x, y, z uint) [3]float32 {
+	w, h, d := vg.counts[0], vg.counts[1], vg.counts[2]
+
+	xm, xp := x, x
+	if x > 0 {
+		xm = x - 1
+	}
+	if x < w-1 {
+		xp = x + 1
+	}
+
+	ym, yp := y, y
+	if y > 0 {
+		ym = y - 1
+	}
+	if y < h-1 {
+		yp = y + 1
+	}
+
+	zm, zp := z, z
+	if z > 0 {
+		zm = z - 1
+	}
+	if z < d-1 {
+		zp = z + 1
+	}
+
+	return [3]float32{
+		vg.cornerValue(xp, y, z) - vg.cornerValue(xm, y, z),
+		vg.cornerValue(x, yp, z) - vg.cornerValue(x, ym, z),
+		vg.cornerValue(x, y, zp) - vg.cornerValue(x, y, zm),
+	}
+}
+
+// interpPosition linearly interpolates the world-space position of an isosurface crossing between two
+// grid corners at parameter t.
+func (vg *VoxelGrid) interpPosition(a, b [3]uint, t float32) [3]float32 {
+	pa, pb := vg.cornerPosition(a[0], a[1], a[2]), vg.cornerPosition(b[0], b[1], b[2])
+	return [3]float32{
+		pa[0] + t*(pb[0]-pa[0]),
+		pa[1] + t*(pb[1]-pa[1]),
+		pa[2] + t*(pb[2]-pa[2]),
+	}
+}
+
+// interpNormal interpolates and normalizes the surface normal at an isosurface crossing between two grid
+// corners at parameter t, derived from the central-difference gradients at each corner.
+func (vg *VoxelGrid) interpNormal(a, b [3]uint, t float32) [3]float32 {
+	ga, gb := vg.cornerGradient(a[0], a[1], a[2]), vg.cornerGradient(b[0], b[1], b[2])
+	n := [3]float32{
+		ga[0] + t*(gb[0]-ga[0]),
+		ga[1] + t*(gb[1]-ga[1]),
+		ga[2] + t*(gb[2]-ga[2]),
+	}
+
+	length := math32.Sqrt(n[0]*n[0] + n[1]*n[1] + n[2]*n[2])
+	if length == 0 {
+		return n
+	}
+
+	return [3]float32{-n[0] / length, -n[1] / length, -n[2] / length}
+}
+
+// edgeKey builds a stable lookup key for a cube edge from the linear indices of its two corners,
+// independent of the order they're given in, so that adjacent cells sharing an edge produce one vertex.
+func edgeKey(a, b uint) uint64 {
+	if a > b {
+		a, b = b, a
+	}
+	return uint64(a)<<32 | uint64(b)
 }
@@ -0,0 +1,77 @@
+package core
+
+import (
+	math32 "github.com/chewxy/math32"
+	"testing"
+)
+
+// TestMeshUniformGridIsEmpty verifies that a grid with no isosurface crossings produces no geometry.
+func TestMeshUniformGridIsEmpty(t *testing.T) {
+	vg := NewVoxelGrid(2, 2, 2, 4)
+	vg.Fill(0)
+
+	vertices, normals, indices := vg.Mesh()
+	if len(vertices) != 0 || len(normals) != 0 || len(indices) != 0 {
+		t.Fatalf("expected no geometry for a uniform grid, got %d vertices, %d normals, %d indices",
+			len(vertices), len(normals), len(indices))
+	}
+}
+
+// TestMeshSphereIsClosedSurface fills a grid with a smooth spherical density field and checks that the
+// resulting mesh is a single closed, genus-0 surface by way of its Euler characteristic (V - E + F == 2).
+func TestMeshSphereIsClosedSurface(t *testing.T) {
+	const size = 20
+	vg := NewVoxelGrid(2, 2, 2, size/2)
+
+	center := [3]float32{size / 2, size / 2, size / 2}
+	radius := float32(8)
+
+	for z := uint(0); z < size; z++ {
+		for y := uint(0); y < size; y++ {
+			for x := uint(0); x < size; x++ {
+				dx, dy, dz := float32(x)-center[0], float32(y)-center[1], float32(z)-center[2]
+				dist := math32.Sqrt(dx*dx + dy*dy + dz*dz)
+				vg.Set(x, y, z, Voxel{Density: 1 - dist/radius})
+			}
+		}
+	}
+
+	vertices, normals, indices := vg.Mesh()
+	if len(vertices) == 0 {
+		t.Fatal("expected the sphere to produce mesh geometry")
+	}
+	if len(normals) != len(vertices) {
+		t.Fatalf("expected one normal per vertex, got %d vertices and %d normals", len(vertices), len(normals))
+	}
+	if len(indices)%3 != 0 {
+		t.Fatalf("expected a whole number of triangles, got %d indices", len(indices))
+	}
+
+	faces := len(indices) / 3
+	edges := 3 * faces / 2
+	euler := len(vertices) - edges + faces
+
+	if euler != 2 {
+		t.Errorf("expected a closed genus-0 surface (Euler characteristic 2), got %d (V=%d E=%d F=%d)",
+			euler, len(vertices), edges, faces)
+	}
+}
+
+// TestMeshMaterialsTagsVerticesWithSolidMaterial checks that MeshMaterials assigns each vertex the
+// Material of the voxel on the solid side of the isosurface it straddles.
+func TestMeshMaterialsTagsVerticesWithSolidMaterial(t *testing.T) {
+	vg := NewVoxelGrid(2, 2, 2, 2)
+	vg.Fill(0)
+	vg.Set(0, 0, 0, Voxel{Density: 1, Material: 7})
+
+	vertices, _, _, materials := vg.MeshMaterials()
+	if len(materials) != len(vertices) {
+		t.Fatalf("expected one material per vertex, got %d vertices and %d materials", len(vertices), len(materials))
+	}
+
+	for _, m := range materials {
+		if m != 7 {
+			t.Errorf("expected every vertex to take the solid corner's material 7, got %d", m)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	math32 "github.com/chewxy/math32"
+	"github.com/go-gl/mathgl/mgl32"
+)
+
+// orbitCamera looks at target from a point on a sphere of radius distance, parameterized by yaw and
+// pitch around it -- the standard orbit/pan/zoom camera used to inspect a bounded volume.
+type orbitCamera struct {
+	target       mgl32.Vec3
+	distance     float32
+	yaw, pitch   float32
+	fovY, aspect float32
+	near, far    float32
+}
+
+// newOrbitCamera returns a camera framing center from distance away, looking down the -Z axis.
+func newOrbitCamera(center mgl32.Vec3, distance float32) *orbitCamera {
+	return &orbitCamera{
+		target:   center,
+		distance: distance,
+		pitch:    0.4,
+		fovY:     45,
+		aspect:   4.0 / 3.0,
+		near:     0.01,
+		far:      100,
+	}
+}
+
+// orbit rotates the camera around its target by the given yaw/pitch deltas (radians), clamping pitch
+// to avoid flipping over the poles.
+func (c *orbitCamera) orbit(dYaw, dPitch float32) {
+	c.yaw += dYaw
+	c.pitch += dPitch
+
+	const maxPitch = 1.55 // just under pi/2, so the camera never quite reaches the poles
+	if c.pitch > maxPitch {
+		c.pitch = maxPitch
+	}
+	if c.pitch < -maxPitch {
+		c.pitch = -maxPitch
+	}
+}
+
+// pan translates the camera's target across its local right/up plane.
+func (c *orbitCamera) pan(dRight, dUp float32) {
+	right, up, _ := c.basis()
+	c.target = c.target.Add(right.Mul(dRight)).Add(up.Mul(dUp))
+}
+
+// zoom moves the camera toward or away from its target, clamping to a minimum distance so it can't
+// pass through it.
+func (c *orbitCamera) zoom(delta float32) {
+	c.distance += delta
+	if c.distance < 0.1 {
+		c.distance = 0.1
+	}
+}
+
+// position returns the camera's eye position in world space.
+func (c *orbitCamera) position() mgl32.Vec3 {
+	x := c.distance * math32.Cos(c.pitch) * math32.Sin(c.yaw)
+	y := c.distance * math32.Sin(c.pitch)
+	z := c.distance * math32.Cos(c.pitch) * math32.Cos(c.yaw)
+	return c.target.Add(mgl32.Vec3{x, y, z})
+}
+
+// basis returns the camera's local right, up, and forward (toward target) axes.
+func (c *orbitCamera) basis() (right, up, forward mgl32.Vec3) {
+	forward = c.target.Sub(c.position()).Normalize()
+	right = forward.Cross(mgl32.Vec3{0, 1, 0}).Normalize()
+	up = right.Cross(forward).Normalize()
+	return
+}
+
+// viewProj returns the camera's combined view-projection matrix.
+func (c *orbitCamera) viewProj() mgl32.Mat4 {
+	view := mgl32.LookAtV(c.position(), c.target, mgl32.Vec3{0, 1, 0})
+	proj := mgl32.Perspective(mgl32.DegToRad(c.fovY), c.aspect, c.near, c.far)
+	return proj.Mul4(view)
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"github.com/go-gl/glfw/v3.2/glfw"
+)
+
+const (
+	orbitSpeed = 0.005
+	panSpeed   = 0.002
+	zoomSpeed  = 0.2
+	isoStep    = 0.02
+)
+
+// installCallbacks wires GLFW mouse and keyboard input to v's camera and render-mode toggles: left-drag
+// orbits, right-drag (or shift+left-drag) pans, scroll zooms, M toggles isosurface/DVR mode, [ and ]
+// lower and raise the isolevel, and Escape closes the window.
+func installCallbacks(window *glfw.Window, v *viewer) {
+	window.SetMouseButtonCallback(func(w *glfw.Window, button glfw.MouseButton, action glfw.Action, mods glfw.ModifierKey) {
+		switch button {
+		case glfw.MouseButtonLeft:
+			switch {
+			case action == glfw.Press && mods&glfw.ModShift != 0:
+				v.panning = true
+			case action == glfw.Press:
+				v.dragging = true
+			default:
+				v.dragging = false
+				v.panning = false
+			}
+		case glfw.MouseButtonRight:
+			v.panning = action == glfw.Press
+		}
+		v.lastCursorX, v.lastCursorY = w.GetCursorPos()
+	})
+
+	window.SetCursorPosCallback(func(w *glfw.Window, xpos, ypos float64) {
+		dx := float32(xpos - v.lastCursorX)
+		dy := float32(ypos - v.lastCursorY)
+		v.lastCursorX, v.lastCursorY = xpos, ypos
+
+		switch {
+		case v.dragging:
+			v.camera.orbit(dx*orbitSpeed, -dy*orbitSpeed)
+		case v.panning:
+			v.camera.pan(-dx*panSpeed, dy*panSpeed)
+		}
+	})
+
+	window.SetScrollCallback(func(w *glfw.Window, xoff, yoff float64) {
+		v.camera.zoom(float32(-yoff) * zoomSpeed)
+	})
+
+	window.SetKeyCallback(func(w *glfw.Window, key glfw.Key, scancode int, action glfw.Action, mods glfw.ModifierKey) {
+		if action != glfw.Press && action != glfw.Repeat {
+			return
+		}
+
+		switch key {
+		case glfw.KeyM:
+			v.isosurfaceMode = !v.isosurfaceMode
+		case glfw.KeyLeftBracket:
+			v.isolevel -= isoStep
+		case glfw.KeyRightBracket:
+			v.isolevel += isoStep
+		case glfw.KeyEscape:
+			w.SetShouldClose(true)
+		}
+	})
+}
@@ -1,35 +1,120 @@
 package main
 
 import (
-	"github.com/go-gl/gl/all-core/gl"
-	"github.com/go-gl/glfw/v3.2/glfw"
 	"log"
 	"runtime"
+
+	"github.com/go-gl/gl/all-core/gl"
+	"github.com/go-gl/glfw/v3.2/glfw"
+	"github.com/go-gl/mathgl/mgl32"
+
+	"github.com/Glenn-Gray-Labs/OpenPAF/core"
+	"github.com/Glenn-Gray-Labs/OpenPAF/core/generate"
 )
 
 const (
-	width = 1024
+	width  = 1024
 	height = 768
 )
 
+// quadVertices is a full-screen triangle strip in clip space; the fragment shader reconstructs a view
+// ray per pixel, so no other per-vertex attributes are needed.
+var quadVertices = []float32{
+	-1, -1,
+	1, -1,
+	-1, 1,
+	1, 1,
+}
+
+// viewer holds the state the render loop and input callbacks share.
+type viewer struct {
+	camera *orbitCamera
+
+	raymarchProgram uint32
+	pointProgram    uint32
+	quadVAO         uint32
+	pointVAO        uint32
+	pointCount      int32
+
+	volumeTexture uint32
+	usePoints     bool
+
+	boundsMin, boundsMax [3]float32
+
+	isolevel       float32
+	isosurfaceMode bool
+	dragging       bool
+	panning        bool
+	lastCursorX    float64
+	lastCursorY    float64
+}
+
 func main() {
 	runtime.LockOSThread()
 
 	window := initGLFW()
 	defer glfw.Terminate()
 
-	program := initOpenGL()
+	initOpenGL()
+
+	vg := buildDemoGrid()
+
+	v := &viewer{
+		camera:         newOrbitCamera(mgl32.Vec3{0, 0, 0}, 3),
+		isolevel:       core.DefaultIsolevel,
+		isosurfaceMode: true,
+	}
+	v.camera.aspect = float32(width) / float32(height)
+
+	v.boundsMin, v.boundsMax = vg.Bounds()
+
+	v.volumeTexture = uploadVolume(vg)
+	v.usePoints = v.volumeTexture == 0
+
+	var err error
+	if v.usePoints {
+		v.pointProgram, err = linkProgram(pointVertexShader, pointFragmentShader)
+	} else {
+		v.raymarchProgram, err = linkProgram(quadVertexShader, raymarchFragmentShader)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	v.quadVAO = newQuadVAO()
+	v.pointVAO, v.pointCount = newPointVAO(vg)
+
+	installCallbacks(window, v)
 
 	for !window.ShouldClose() {
+		gl.Viewport(0, 0, width, height)
+		gl.ClearColor(0.05, 0.05, 0.08, 1.0)
 		gl.Clear(gl.COLOR_BUFFER_BIT | gl.DEPTH_BUFFER_BIT)
-		gl.UseProgram(program)
 
+		if v.usePoints {
+			v.drawPoints()
+		} else {
+			v.drawVolume()
+		}
 
 		glfw.PollEvents()
 		window.SwapBuffers()
 	}
 }
 
+// buildDemoGrid procedurally fills a small grid with a sphere SDF so there's something to see on
+// launch; a real editor would load a saved VoxelGrid instead.
+func buildDemoGrid() *core.VoxelGrid {
+	vg := core.NewBoundedVoxelGrid([3]float32{-1, -1, -1}, [3]float32{1, 1, 1}, 32)
+
+	sdf := generate.Sphere([3]float32{0, 0, 0}, 0.7)
+	vg.Apply(func(x, y, z float32) float32 {
+		return core.DefaultIsolevel - sdf(x, y, z)
+	})
+
+	return vg
+}
+
 func initGLFW() *glfw.Window {
 	if err := glfw.Init(); err != nil {
 		panic(err)
@@ -50,16 +135,11 @@ func initGLFW() *glfw.Window {
 	return window
 }
 
-func initOpenGL() uint32 {
+func initOpenGL() {
 	if err := gl.Init(); err != nil {
 		panic(err)
 	}
 
 	version := gl.GoStr(gl.GetString(gl.VERSION))
 	log.Println("OpenGL version", version)
-
-	program := gl.CreateProgram()
-	gl.LinkProgram(program)
-
-	return program
 }
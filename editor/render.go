@@ -0,0 +1,131 @@
+package main
+
+import (
+	"github.com/go-gl/gl/all-core/gl"
+	"github.com/go-gl/mathgl/mgl32"
+
+	"github.com/Glenn-Gray-Labs/OpenPAF/core"
+)
+
+// newQuadVAO uploads the full-screen triangle strip used to drive the raymarch fragment shader.
+func newQuadVAO() uint32 {
+	var vao, vbo uint32
+	gl.GenVertexArrays(1, &vao)
+	gl.GenBuffers(1, &vbo)
+
+	gl.BindVertexArray(vao)
+	gl.BindBuffer(gl.ARRAY_BUFFER, vbo)
+	gl.BufferData(gl.ARRAY_BUFFER, len(quadVertices)*4, gl.Ptr(quadVertices), gl.STATIC_DRAW)
+	gl.VertexAttribPointer(0, 2, gl.FLOAT, false, 2*4, nil)
+	gl.EnableVertexAttribArray(0)
+	gl.BindVertexArray(0)
+
+	return vao
+}
+
+// newPointVAO uploads vg's VertexPoints() as a GL_POINTS buffer, the fallback rendering path used when
+// 3D textures aren't available. Returns the VAO and the number of points it holds.
+func newPointVAO(vg *core.VoxelGrid) (uint32, int32) {
+	points := vg.VertexPoints()
+	if len(points) == 0 {
+		var vao uint32
+		gl.GenVertexArrays(1, &vao)
+		return vao, 0
+	}
+
+	data := make([]float32, 0, len(points)*3)
+	for _, p := range points {
+		data = append(data, p[0], p[1], p[2])
+	}
+
+	var vao, vbo uint32
+	gl.GenVertexArrays(1, &vao)
+	gl.GenBuffers(1, &vbo)
+
+	gl.BindVertexArray(vao)
+	gl.BindBuffer(gl.ARRAY_BUFFER, vbo)
+	gl.BufferData(gl.ARRAY_BUFFER, len(data)*4, gl.Ptr(data), gl.STATIC_DRAW)
+	gl.VertexAttribPointer(0, 3, gl.FLOAT, false, 3*4, nil)
+	gl.EnableVertexAttribArray(0)
+	gl.BindVertexArray(0)
+
+	return vao, int32(len(points))
+}
+
+// drawVolume ray-marches the uploaded 3D density texture from the current camera, either accumulating
+// density (DVR) or stopping at the first isosurface crossing, per v.isosurfaceMode.
+func (v *viewer) drawVolume() {
+	gl.UseProgram(v.raymarchProgram)
+
+	viewProj := v.camera.viewProj()
+	invViewProj := viewProj.Inv()
+
+	setUniformMat4(v.raymarchProgram, "invViewProj", invViewProj)
+	setUniform3f(v.raymarchProgram, "cameraPos", v.camera.position())
+	setUniform2f(v.raymarchProgram, "viewport", float32(width), float32(height))
+	setUniform3fv(v.raymarchProgram, "boundsMin", v.boundsMin)
+	setUniform3fv(v.raymarchProgram, "boundsMax", v.boundsMax)
+	setUniform1f(v.raymarchProgram, "isolevel", v.isolevel)
+	setUniform1i(v.raymarchProgram, "isosurfaceMode", boolToInt(v.isosurfaceMode))
+	setUniform1i(v.raymarchProgram, "steps", 256)
+
+	gl.ActiveTexture(gl.TEXTURE0)
+	gl.BindTexture(gl.TEXTURE_3D, v.volumeTexture)
+	setUniform1i(v.raymarchProgram, "volume", 0)
+
+	gl.BindVertexArray(v.quadVAO)
+	gl.DrawArrays(gl.TRIANGLE_STRIP, 0, 4)
+	gl.BindVertexArray(0)
+}
+
+// drawPoints renders the VertexPoints() fallback as GL_POINTS, used when 3D textures aren't supported.
+func (v *viewer) drawPoints() {
+	if v.pointCount == 0 {
+		return
+	}
+
+	gl.UseProgram(v.pointProgram)
+	setUniformMat4(v.pointProgram, "viewProj", v.camera.viewProj())
+
+	gl.Enable(gl.PROGRAM_POINT_SIZE)
+	gl.BindVertexArray(v.pointVAO)
+	gl.DrawArrays(gl.POINTS, 0, v.pointCount)
+	gl.BindVertexArray(0)
+}
+
+func boolToInt(b bool) int32 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func setUniformMat4(program uint32, name string, m mgl32.Mat4) {
+	loc := gl.GetUniformLocation(program, gl.Str(name+"\x00"))
+	gl.UniformMatrix4fv(loc, 1, false, &m[0])
+}
+
+func setUniform3f(program uint32, name string, v mgl32.Vec3) {
+	loc := gl.GetUniformLocation(program, gl.Str(name+"\x00"))
+	gl.Uniform3f(loc, v[0], v[1], v[2])
+}
+
+func setUniform3fv(program uint32, name string, v [3]float32) {
+	loc := gl.GetUniformLocation(program, gl.Str(name+"\x00"))
+	gl.Uniform3f(loc, v[0], v[1], v[2])
+}
+
+func setUniform2f(program uint32, name string, x, y float32) {
+	loc := gl.GetUniformLocation(program, gl.Str(name+"\x00"))
+	gl.Uniform2f(loc, x, y)
+}
+
+func setUniform1f(program uint32, name string, x float32) {
+	loc := gl.GetUniformLocation(program, gl.Str(name+"\x00"))
+	gl.Uniform1f(loc, x)
+}
+
+func setUniform1i(program uint32, name string, x int32) {
+	loc := gl.GetUniformLocation(program, gl.Str(name+"\x00"))
+	gl.Uniform1i(loc, x)
+}
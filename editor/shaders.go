@@ -0,0 +1,212 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-gl/gl/all-core/gl"
+)
+
+// quadVertexShader draws a full-screen triangle strip; the fragment shader derives the view ray for
+// each pixel itself, so no per-vertex data beyond clip-space position is needed.
+const quadVertexShader = `
+#version 410 core
+
+layout (location = 0) in vec2 position;
+
+void main() {
+    gl_Position = vec4(position, 0.0, 1.0);
+}
+`
+
+// raymarchFragmentShader ray-marches a 3D density texture. It intersects the view ray with the grid's
+// world-space AABB (a slab test), steps along the ray sampling the texture at each point remapped into
+// [0,1]^3 texture space, and either accumulates density front-to-back
+// (DVR mode) or stops at the first sample crossing isolevel and shades it with a central-difference
+// gradient normal (isosurface mode).
+const raymarchFragmentShader = `
+#version 410 core
+
+uniform sampler3D volume;
+uniform mat4 invViewProj;
+uniform vec3 cameraPos;
+uniform vec2 viewport;
+uniform vec3 boundsMin;
+uniform vec3 boundsMax;
+uniform float isolevel;
+uniform int isosurfaceMode; // 0 = DVR accumulation, 1 = isosurface hit
+uniform int steps;
+
+out vec4 fragColor;
+
+const vec3 lightDir = normalize(vec3(0.5, 0.8, 0.3));
+
+// intersectBox performs a slab test against the grid's world-space AABB (boundsMin/boundsMax) and
+// returns the entry/exit distances along the ray; exit < entry means the ray misses the box entirely.
+vec2 intersectBox(vec3 origin, vec3 dir) {
+    vec3 invDir = 1.0 / dir;
+    vec3 tMin = (boundsMin - origin) * invDir;
+    vec3 tMax = (boundsMax - origin) * invDir;
+    vec3 t1 = min(tMin, tMax);
+    vec3 t2 = max(tMin, tMax);
+    float tNear = max(max(t1.x, t1.y), t1.z);
+    float tFar = min(min(t2.x, t2.y), t2.z);
+    return vec2(tNear, tFar);
+}
+
+// worldToTex remaps a world-space point inside [boundsMin, boundsMax] to the [0,1]^3 texture-space
+// coordinate the volume sampler expects.
+vec3 worldToTex(vec3 p) {
+    return (p - boundsMin) / (boundsMax - boundsMin);
+}
+
+vec3 gradientAt(vec3 uvw) {
+    vec3 texel = 1.0 / vec3(textureSize(volume, 0));
+    float dx = texture(volume, uvw + vec3(texel.x, 0.0, 0.0)).r - texture(volume, uvw - vec3(texel.x, 0.0, 0.0)).r;
+    float dy = texture(volume, uvw + vec3(0.0, texel.y, 0.0)).r - texture(volume, uvw - vec3(0.0, texel.y, 0.0)).r;
+    float dz = texture(volume, uvw + vec3(0.0, 0.0, texel.z)).r - texture(volume, uvw - vec3(0.0, 0.0, texel.z)).r;
+    return normalize(-vec3(dx, dy, dz));
+}
+
+void main() {
+    vec2 ndc = (gl_FragCoord.xy / viewport) * 2.0 - 1.0;
+    vec4 near = invViewProj * vec4(ndc, -1.0, 1.0);
+    vec4 far = invViewProj * vec4(ndc, 1.0, 1.0);
+    near /= near.w;
+    far /= far.w;
+
+    vec3 rayOrigin = cameraPos;
+    vec3 rayDir = normalize(far.xyz - near.xyz);
+
+    vec2 hit = intersectBox(rayOrigin, rayDir);
+    if (hit.y < hit.x || hit.y < 0.0) {
+        discard;
+    }
+
+    float tStart = max(hit.x, 0.0);
+    float tEnd = hit.y;
+    float stepSize = (tEnd - tStart) / float(steps);
+
+    vec4 accum = vec4(0.0);
+    float t = tStart;
+
+    for (int i = 0; i < steps; i++) {
+        vec3 p = rayOrigin + rayDir * t;
+        vec3 uvw = worldToTex(p);
+        float density = texture(volume, uvw).r;
+
+        if (isosurfaceMode == 1) {
+            if (density >= isolevel) {
+                vec3 normal = gradientAt(uvw);
+                float diffuse = max(dot(normal, lightDir), 0.0);
+                fragColor = vec4(vec3(0.2 + 0.8 * diffuse), 1.0);
+                return;
+            }
+        } else {
+            float a = density * (1.0 - accum.a) * 0.15;
+            accum.rgb += vec3(density) * a;
+            accum.a += a;
+            if (accum.a >= 0.99) {
+                break;
+            }
+        }
+
+        t += stepSize;
+        if (t > tEnd) {
+            break;
+        }
+    }
+
+    if (isosurfaceMode == 1) {
+        discard;
+    }
+
+    fragColor = accum;
+}
+`
+
+// pointVertexShader renders VertexPoints()-style voxel corner positions as GL_POINTS, used as a fallback when
+// GL_TEXTURE_3D isn't available.
+const pointVertexShader = `
+#version 410 core
+
+layout (location = 0) in vec3 position;
+
+uniform mat4 viewProj;
+
+void main() {
+    gl_Position = viewProj * vec4(position, 1.0);
+    gl_PointSize = 4.0;
+}
+`
+
+const pointFragmentShader = `
+#version 410 core
+
+out vec4 fragColor;
+
+void main() {
+    fragColor = vec4(0.8, 0.8, 0.8, 1.0);
+}
+`
+
+// compileShader compiles a single GLSL shader stage and returns its handle, or an error containing the
+// driver's info log on failure.
+func compileShader(source string, shaderType uint32) (uint32, error) {
+	shader := gl.CreateShader(shaderType)
+
+	csource, free := gl.Strs(source + "\x00")
+	gl.ShaderSource(shader, 1, csource, nil)
+	free()
+	gl.CompileShader(shader)
+
+	var status int32
+	gl.GetShaderiv(shader, gl.COMPILE_STATUS, &status)
+	if status == gl.FALSE {
+		var logLength int32
+		gl.GetShaderiv(shader, gl.INFO_LOG_LENGTH, &logLength)
+
+		log := strings.Repeat("\x00", int(logLength+1))
+		gl.GetShaderInfoLog(shader, logLength, nil, gl.Str(log))
+
+		return 0, fmt.Errorf("failed to compile shader: %v", log)
+	}
+
+	return shader, nil
+}
+
+// linkProgram compiles and links a vertex/fragment shader pair into a program, or returns an error
+// containing the driver's info log on failure.
+func linkProgram(vertexSource, fragmentSource string) (uint32, error) {
+	vertexShader, err := compileShader(vertexSource, gl.VERTEX_SHADER)
+	if err != nil {
+		return 0, err
+	}
+
+	fragmentShader, err := compileShader(fragmentSource, gl.FRAGMENT_SHADER)
+	if err != nil {
+		return 0, err
+	}
+
+	program := gl.CreateProgram()
+	gl.AttachShader(program, vertexShader)
+	gl.AttachShader(program, fragmentShader)
+	gl.LinkProgram(program)
+
+	var status int32
+	gl.GetProgramiv(program, gl.LINK_STATUS, &status)
+	if status == gl.FALSE {
+		var logLength int32
+		gl.GetProgramiv(program, gl.INFO_LOG_LENGTH, &logLength)
+
+		log := strings.Repeat("\x00", int(logLength+1))
+		gl.GetProgramInfoLog(program, logLength, nil, gl.Str(log))
+
+		return 0, fmt.Errorf("failed to link program: %v", log)
+	}
+
+	gl.DeleteShader(vertexShader)
+	gl.DeleteShader(fragmentShader)
+
+	return program, nil
+}
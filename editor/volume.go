@@ -0,0 +1,61 @@
+package main
+
+import (
+	"github.com/go-gl/gl/all-core/gl"
+
+	"github.com/Glenn-Gray-Labs/OpenPAF/core"
+)
+
+// uploadVolume copies vg's density field into a GL_TEXTURE_3D (GL_R8) the raymarch shader samples in
+// [0,1] texture-space, one texel per voxel. Returns 0 if 3D textures aren't supported by the driver.
+func uploadVolume(vg *core.VoxelGrid) uint32 {
+	if !supportsTexture3D() {
+		return 0
+	}
+
+	dims := vg.Dimensions()
+	w, h, d := int32(dims[0]), int32(dims[1]), int32(dims[2])
+
+	density := make([]uint8, dims[0]*dims[1]*dims[2])
+	i := 0
+	for z := uint(0); z < dims[2]; z++ {
+		for y := uint(0); y < dims[1]; y++ {
+			for x := uint(0); x < dims[0]; x++ {
+				density[i] = quantize(vg.Get(x, y, z).Density)
+				i++
+			}
+		}
+	}
+
+	var texture uint32
+	gl.GenTextures(1, &texture)
+	gl.BindTexture(gl.TEXTURE_3D, texture)
+	gl.TexParameteri(gl.TEXTURE_3D, gl.TEXTURE_MIN_FILTER, gl.LINEAR)
+	gl.TexParameteri(gl.TEXTURE_3D, gl.TEXTURE_MAG_FILTER, gl.LINEAR)
+	gl.TexParameteri(gl.TEXTURE_3D, gl.TEXTURE_WRAP_S, gl.CLAMP_TO_EDGE)
+	gl.TexParameteri(gl.TEXTURE_3D, gl.TEXTURE_WRAP_T, gl.CLAMP_TO_EDGE)
+	gl.TexParameteri(gl.TEXTURE_3D, gl.TEXTURE_WRAP_R, gl.CLAMP_TO_EDGE)
+	gl.TexImage3D(gl.TEXTURE_3D, 0, gl.R8, w, h, d, 0, gl.RED, gl.UNSIGNED_BYTE, gl.Ptr(density))
+
+	return texture
+}
+
+// supportsTexture3D reports whether the current context exposes GL_TEXTURE_3D; it's universally
+// available on core OpenGL 4.1+ contexts but checked explicitly so the editor can fall back to
+// GL_POINTS rendering on older or software drivers that don't.
+func supportsTexture3D() bool {
+	var maxSize int32
+	gl.GetIntegerv(gl.MAX_3D_TEXTURE_SIZE, &maxSize)
+	return maxSize > 0
+}
+
+// quantize converts a density in roughly [0,1] to a uint8 texel value, clamping out-of-range input.
+func quantize(v float32) uint8 {
+	if v <= 0 {
+		return 0
+	}
+	if v >= 1 {
+		return 255
+	}
+	return uint8(v*255 + 0.5)
+}